@@ -2,6 +2,7 @@ package agent
 
 import (
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -11,10 +12,9 @@ import (
 	"github.com/go-rfe/gpwd/internal/agent"
 	"github.com/go-rfe/gpwd/internal/encryption"
 	"github.com/go-rfe/gpwd/internal/logging/log"
-)
-
-const (
-	minimumPasswordLength = 32
+	"github.com/go-rfe/gpwd/internal/storage/local"
+	// Register the default SQLite storage backend
+	_ "github.com/go-rfe/gpwd/internal/storage/local/sqlite"
 )
 
 // agentCmd represents the agent command for starting gpwd local agent
@@ -22,6 +22,12 @@ var agentCmd = &cobra.Command{
 	Use:   "agent",
 	Short: "gpwd passwords store agent",
 	Long:  `Simple passwords store agent with SQLite backend`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Agent subcommands share these keys with other commands, bind them to the agent flags
+		cobra.CheckErr(viper.BindPFlag("socket_path", cmd.Flags().Lookup("socketPath")))
+		cobra.CheckErr(viper.BindPFlag("cert_path", cmd.Flags().Lookup("certPath")))
+		cobra.CheckErr(viper.BindPFlag("timeout", cmd.Flags().Lookup("timeout")))
+	},
 	Run: func(cmd *cobra.Command, args []string) {
 		var password []byte
 		var err error
@@ -30,12 +36,12 @@ var agentCmd = &cobra.Command{
 			if err != nil {
 				log.Fatal().Msgf("Failed to read master password: %s", err)
 			}
-			if len(string(password)) < minimumPasswordLength {
-				log.Fatal().Msgf("minimum master password length is %d characters", minimumPasswordLength)
-			}
 		} else {
 			password = []byte(viper.GetString("master_password"))
 		}
+		if len(password) == 0 {
+			log.Fatal().Msg("master password can't be empty")
+		}
 		viper.Set("master_password", password)
 
 		config := agent.Cfg{}
@@ -43,6 +49,7 @@ var agentCmd = &cobra.Command{
 		if err := viper.Unmarshal(&config); err != nil {
 			log.Fatal().Msgf("Failed to read agent config: %s", err)
 		}
+		viper.Set("master_password", nil)
 
 		log.Debug().Msgf("Using socket: %s", config.SocketPath)
 		agent.NewAgent(&config).Run()
@@ -50,7 +57,11 @@ var agentCmd = &cobra.Command{
 }
 
 const (
-	defaultSyncInterval = 10 * time.Second
+	defaultSyncInterval      = 10 * time.Second
+	defaultLockTimeout       = 15 * time.Minute
+	defaultVersionsRetention = 10
+	defaultTrashRetention    = 30 * 24 * time.Hour
+	defaultTimeout           = 10 * time.Second
 )
 
 var (
@@ -65,22 +76,49 @@ func init() {
 
 	root.AddCommand(agentCmd)
 
-	agentCmd.Flags().String("socketPath", home+"/.gpwd/gpwd.sock", "Agent socket path")
-	cobra.CheckErr(viper.BindPFlag("socket_path", agentCmd.Flags().Lookup("socketPath")))
+	agentCmd.PersistentFlags().String("socketPath", home+"/.gpwd/gpwd.sock", "Agent socket path")
+	cobra.CheckErr(viper.BindPFlag("socket_path", agentCmd.PersistentFlags().Lookup("socketPath")))
+
+	agentCmd.PersistentFlags().Duration("timeout", defaultTimeout, "Agent timeout")
 
 	agentCmd.Flags().Duration("syncInterval", defaultSyncInterval, "Time interval for sync data to server")
 	cobra.CheckErr(viper.BindPFlag("sync_interval", agentCmd.Flags().Lookup("syncInterval")))
 
-	agentCmd.Flags().String("storePath", home+"/.gpwd/", "Agent storage path")
-	cobra.CheckErr(viper.BindPFlag("store_path", agentCmd.Flags().Lookup("storePath")))
+	agentCmd.Flags().Duration("lockTimeout", defaultLockTimeout, "Lock the agent after this period without use of the vault key, 0 disables auto-lock")
+	cobra.CheckErr(viper.BindPFlag("lock_timeout", agentCmd.Flags().Lookup("lockTimeout")))
+
+	agentCmd.Flags().IntSlice("allowedUIDs", nil, "Users allowed to connect to the agent socket besides the agent owner")
+	cobra.CheckErr(viper.BindPFlag("allowed_uids", agentCmd.Flags().Lookup("allowedUIDs")))
 
-	agentCmd.Flags().String("certPath", home+"/.gpwd/agent.pem", "Agent TLS certificate PEM file")
-	cobra.CheckErr(viper.BindPFlag("agent_cert_path", agentCmd.Flags().Lookup("certPath")))
+	agentCmd.Flags().Int("versionsRetention", defaultVersionsRetention, "Number of prior secret versions to keep, 0 keeps all of them")
+	cobra.CheckErr(viper.BindPFlag("agent_versions_retention", agentCmd.Flags().Lookup("versionsRetention")))
+
+	agentCmd.Flags().Duration("trashRetention", defaultTrashRetention, "Purge deleted secrets after this period, 0 keeps them forever")
+	cobra.CheckErr(viper.BindPFlag("agent_trash_retention", agentCmd.Flags().Lookup("trashRetention")))
+
+	agentCmd.PersistentFlags().String("storePath", home+"/.gpwd/", "Agent storage path")
+	cobra.CheckErr(viper.BindPFlag("store_path", agentCmd.PersistentFlags().Lookup("storePath")))
+
+	agentCmd.PersistentFlags().String("storeBackend", local.SQLiteBackend,
+		"Agent storage backend, one of: "+strings.Join(local.Backends(), ", "))
+	cobra.CheckErr(viper.BindPFlag("store_backend", agentCmd.PersistentFlags().Lookup("storeBackend")))
+
+	agentCmd.PersistentFlags().String("certPath", home+"/.gpwd/agent.pem", "Agent TLS certificate PEM file")
+	cobra.CheckErr(viper.BindPFlag("agent_cert_path", agentCmd.PersistentFlags().Lookup("certPath")))
 
 	agentCmd.Flags().String("keyPath", home+"/.gpwd/agent-key.pem", "Agent TLS key PEM file")
 	cobra.CheckErr(viper.BindPFlag("agent_key_path", agentCmd.Flags().Lookup("keyPath")))
 
-	agentCmd.Flags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.gpwd.yaml)")
+	agentCmd.PersistentFlags().Uint32("kdfTime", encryption.DefaultKDFTime, "Argon2id iterations used to wrap the vault key")
+	cobra.CheckErr(viper.BindPFlag("kdf_time", agentCmd.PersistentFlags().Lookup("kdfTime")))
+
+	agentCmd.PersistentFlags().Uint32("kdfMemory", encryption.DefaultKDFMemory, "Argon2id memory in KiB used to wrap the vault key")
+	cobra.CheckErr(viper.BindPFlag("kdf_memory", agentCmd.PersistentFlags().Lookup("kdfMemory")))
+
+	agentCmd.PersistentFlags().Uint8("kdfThreads", encryption.DefaultKDFThreads, "Argon2id parallelism used to wrap the vault key")
+	cobra.CheckErr(viper.BindPFlag("kdf_threads", agentCmd.PersistentFlags().Lookup("kdfThreads")))
+
+	agentCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.gpwd.yaml)")
 }
 
 // InitConfig reads in config file and ENV variables if set.
@@ -0,0 +1,31 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	agentclient "github.com/go-rfe/gpwd/internal/client/agent"
+)
+
+// lockCmd represents the command for locking a running agent
+var lockCmd = &cobra.Command{
+	Use:   "lock",
+	Short: "lock gpwd agent",
+	Long: `Wipes the vault key from the running agent memory.
+Secrets can't be read, created or updated until "gpwd agent unlock"`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := agentclient.NewAgentClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(client.Lock())
+	},
+}
+
+func init() {
+	agentCmd.AddCommand(lockCmd)
+}
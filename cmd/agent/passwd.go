@@ -0,0 +1,78 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/agent"
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// passwdCmd represents the command for changing the agent master password
+var passwdCmd = &cobra.Command{
+	Use:   "passwd",
+	Short: "change gpwd agent master password",
+	Long: `Rewraps the vault data encryption key with a new master password.
+Secrets are not re-encrypted, the current master password or a recovery key is required.
+The vault key of the registered account is updated on the server for other devices of the account`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		var secret []byte
+
+		isRecoveryKey := viper.GetBool("passwd_recovery")
+		if isRecoveryKey {
+			secret, err = encryption.AskForSecretInput("Please type your recovery key:")
+		} else {
+			secret, err = encryption.AskForSecretInput("Please type your current master password:")
+		}
+		cobra.CheckErr(err)
+
+		newPassword, err := encryption.AskForSecretInput("Please type your new master password:")
+		cobra.CheckErr(err)
+
+		confirmation, err := encryption.AskForSecretInput("Please retype your new master password:")
+		cobra.CheckErr(err)
+
+		switch {
+		case len(newPassword) == 0:
+			log.Fatal().Msg("master password can't be empty")
+		case !bytes.Equal(newPassword, confirmation):
+			log.Fatal().Msg("master passwords don't match")
+		}
+
+		config := agent.Cfg{}
+		if err := viper.Unmarshal(&config); err != nil {
+			log.Fatal().Msgf("Failed to read agent config: %s", err)
+		}
+
+		cobra.CheckErr(agent.NewAgent(&config).ChangeMasterPassword(context.Background(), secret, isRecoveryKey, newPassword))
+
+		const status = "Master password changed"
+		cobra.CheckErr(printer.Print(os.Stdout, passwd{Status: status}, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, status)
+			return err
+		}))
+	},
+}
+
+// passwd is the result of the master password change
+type passwd struct {
+	Status string `json:"status" yaml:"status"`
+}
+
+func init() {
+	agentCmd.AddCommand(passwdCmd)
+
+	passwdCmd.Flags().Bool("recovery", false, "Use recovery key instead of the current master password")
+	cobra.CheckErr(viper.BindPFlag("passwd_recovery", passwdCmd.Flags().Lookup("recovery")))
+}
@@ -0,0 +1,54 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/agent"
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// recoveryCmd represents the command for creating the vault recovery key
+var recoveryCmd = &cobra.Command{
+	Use:   "recovery",
+	Short: "create gpwd vault recovery key",
+	Long: `Wraps the vault data encryption key with a new random recovery key.
+The key is printed once and replaces the previous one, keep it in a safe place.
+Use it with "gpwd agent passwd --recovery" if the master password is lost`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		password, err := encryption.AskForSecretInput("Please type your master password:")
+		cobra.CheckErr(err)
+
+		config := agent.Cfg{}
+		if err := viper.Unmarshal(&config); err != nil {
+			log.Fatal().Msgf("Failed to read agent config: %s", err)
+		}
+
+		recoveryKey, err := agent.NewAgent(&config).CreateRecoveryKey(context.Background(), password)
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(printer.Print(os.Stdout, recovery{RecoveryKey: recoveryKey}, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, recoveryKey)
+			return err
+		}))
+	},
+}
+
+// recovery is the created recovery key
+type recovery struct {
+	RecoveryKey string `json:"recovery_key" yaml:"recovery_key"`
+}
+
+func init() {
+	agentCmd.AddCommand(recoveryCmd)
+}
@@ -0,0 +1,34 @@
+package agent
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	agentclient "github.com/go-rfe/gpwd/internal/client/agent"
+	"github.com/go-rfe/gpwd/internal/encryption"
+)
+
+// unlockCmd represents the command for unlocking a running agent
+var unlockCmd = &cobra.Command{
+	Use:   "unlock",
+	Short: "unlock gpwd agent",
+	Long:  `Unwraps the vault key in the running agent with the master password`,
+	Run: func(cmd *cobra.Command, args []string) {
+		password, err := encryption.AskForSecretInput("Please type your master password:")
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := agentclient.NewAgentClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(client.Unlock(password))
+	},
+}
+
+func init() {
+	agentCmd.AddCommand(unlockCmd)
+}
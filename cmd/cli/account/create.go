@@ -3,23 +3,34 @@ package account
 import (
 	"context"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/go-rfe/gpwd/internal/client/accounts"
 	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
 )
 
 // createCmd represents the create command
 var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "create account using gpwd agent",
-	Long:  `cli connects to the agent and sends account info securely.`,
+	Long: `cli connects to the agent and sends account info securely.
+The account is registered on the server, devices joining the account share its vault key,
+so the master password must be the same on all devices of the account`,
 	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
 		password, err := encryption.AskForSecretInput("Please enter user password:")
 		cobra.CheckErr(err)
 
+		masterPassword, err := encryption.AskForSecretInput("Please enter your master password:")
+		cobra.CheckErr(err)
+
 		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
 		defer cancel()
 
@@ -30,9 +41,14 @@ var createCmd = &cobra.Command{
 			viper.GetString("create_server_address"),
 			viper.GetString("create_username"),
 			password,
+			masterPassword,
 		)
 		cobra.CheckErr(err)
-		fmt.Println(id)
+
+		cobra.CheckErr(printer.Print(os.Stdout, account{ID: id}, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, id)
+			return err
+		}))
 	},
 }
 
@@ -2,14 +2,14 @@ package account
 
 import (
 	"context"
-	"fmt"
+	"io"
 	"os"
-	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/go-rfe/gpwd/internal/client/accounts"
+	"github.com/go-rfe/gpwd/internal/output"
 )
 
 // getCmd represents the create command
@@ -18,26 +18,33 @@ var getCmd = &cobra.Command{
 	Short: "get account from gpwd agent",
 	Long:  "cli connects to the agent and gets account",
 	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
 		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
 		defer cancel()
 
 		client, err := accounts.NewAccountsClient(ctx, viper.GetString("socket_path"))
 		cobra.CheckErr(err)
 
-		account, err := client.Get()
-		cobra.CheckErr(err)
-
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.Escape)
-		_, err = fmt.Fprintln(w, "ID", "\t", "Server", "\t", "Username")
+		info, err := client.Get()
 		cobra.CheckErr(err)
 
-		_, err = fmt.Fprintln(w, account.GetID(), "\t", account.GetServerAddress(), "\t", account.GetUserName())
-		cobra.CheckErr(err)
-		cobra.CheckErr(w.Flush())
-
+		view := account{ID: info.GetID(), ServerAddress: info.GetServerAddress(), Username: info.GetUserName()}
+		cobra.CheckErr(printer.Print(os.Stdout, view, func(w io.Writer) error {
+			return output.WriteTable(w, []string{"ID", "Server", "Username"},
+				[][]string{{view.ID, view.ServerAddress, view.Username}})
+		}))
 	},
 }
 
+// account is the stable view of the account, the password is never shown
+type account struct {
+	ID            string `json:"id" yaml:"id"`
+	ServerAddress string `json:"server_address,omitempty" yaml:"server_address,omitempty"`
+	Username      string `json:"username,omitempty" yaml:"username,omitempty"`
+}
+
 func init() {
 	accountCmd.AddCommand(getCmd)
 }
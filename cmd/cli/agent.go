@@ -0,0 +1,69 @@
+// Package cli implements helpers shared by commands talking to the agent
+package cli
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	agentclient "github.com/go-rfe/gpwd/internal/client/agent"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/encryption"
+)
+
+// AddAgentFlags adds flags for the agent connection to the command
+func AddAgentFlags(cmd *cobra.Command, timeout time.Duration) {
+	home, err := os.UserHomeDir()
+	cobra.CheckErr(err)
+
+	// A socket file for agent connection
+	cmd.Flags().String("socketPath", home+"/.gpwd/gpwd.sock", "Agent socket path")
+
+	// An agent response timeout
+	cmd.Flags().Duration("timeout", timeout, "Agent timeout")
+
+	// An agent certificate for auth
+	cmd.Flags().String("certPath", home+"/.gpwd/agent.pem", "Agent TLS certificate PEM file")
+}
+
+// BindAgentFlags binds the agent connection flags, the keys are shared with other commands
+func BindAgentFlags(cmd *cobra.Command, args []string) {
+	cobra.CheckErr(viper.BindPFlag("socket_path", cmd.Flags().Lookup("socketPath")))
+	cobra.CheckErr(viper.BindPFlag("cert_path", cmd.Flags().Lookup("certPath")))
+	cobra.CheckErr(viper.BindPFlag("timeout", cmd.Flags().Lookup("timeout")))
+}
+
+// WithUnlock calls fn, if the agent is locked the master password is asked for once and fn is called again
+func WithUnlock(fn func() error) error {
+	err := fn()
+	if secrets.IsLocked(err) {
+		if err := UnlockAgent(); err != nil {
+			return err
+		}
+
+		err = fn()
+	}
+
+	return err
+}
+
+// UnlockAgent asks for the master password when the agent is locked
+func UnlockAgent() error {
+	password, err := encryption.AskForSecretInput("Agent is locked, please type your master password:")
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+	defer cancel()
+
+	client, err := agentclient.NewAgentClient(ctx, viper.GetString("socket_path"))
+	if err != nil {
+		return err
+	}
+
+	return client.Unlock(password)
+}
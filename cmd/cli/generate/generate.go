@@ -0,0 +1,105 @@
+package generate
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/root"
+	"github.com/go-rfe/gpwd/internal/generator"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// generateCmd represents the generate command
+var generateCmd = &cobra.Command{
+	Use:   "generate",
+	Short: "generate password or passphrase",
+	Long: `Generates a random password of the given character classes
+or a diceware-style passphrase from the embedded EFF word list`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		password, err := Generate("generate")
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(printer.Print(os.Stdout, generated{Password: string(password)}, func(w io.Writer) error {
+			_, err := fmt.Fprintln(w, string(password))
+			return err
+		}))
+	},
+}
+
+// generated is the generated password or passphrase
+type generated struct {
+	Password string `json:"password" yaml:"password"`
+}
+
+// AddFlags adds generator flags to the command, binding them to the prefixed keys
+func AddFlags(cmd *cobra.Command, prefix string) {
+	policy := generator.DefaultPolicy()
+	passphrasePolicy := generator.DefaultPassphrasePolicy()
+
+	cmd.Flags().Int("length", policy.Length, "Password length")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_length", cmd.Flags().Lookup("length")))
+
+	cmd.Flags().Bool("lower", policy.Lower, "Use lowercase letters")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_lower", cmd.Flags().Lookup("lower")))
+
+	cmd.Flags().Bool("upper", policy.Upper, "Use uppercase letters")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_upper", cmd.Flags().Lookup("upper")))
+
+	cmd.Flags().Bool("digits", policy.Digits, "Use digits")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_digits", cmd.Flags().Lookup("digits")))
+
+	cmd.Flags().Bool("symbols", policy.Symbols, "Use symbols")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_symbols", cmd.Flags().Lookup("symbols")))
+
+	cmd.Flags().Bool("excludeAmbiguous", policy.ExcludeAmbiguous, "Exclude ambiguous characters like 0 and O")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_exclude_ambiguous", cmd.Flags().Lookup("excludeAmbiguous")))
+
+	cmd.Flags().Bool("requireEachClass", policy.RequireEachClass, "Include at least one character of every class")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_require_each_class", cmd.Flags().Lookup("requireEachClass")))
+
+	cmd.Flags().Bool("passphrase", false, "Generate diceware-style passphrase instead of password")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_passphrase", cmd.Flags().Lookup("passphrase")))
+
+	cmd.Flags().Int("words", passphrasePolicy.Words, "Passphrase words count")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_words", cmd.Flags().Lookup("words")))
+
+	cmd.Flags().String("separator", passphrasePolicy.Separator, "Passphrase words separator")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_separator", cmd.Flags().Lookup("separator")))
+
+	cmd.Flags().Bool("capitalize", passphrasePolicy.Capitalize, "Capitalize passphrase words")
+	cobra.CheckErr(viper.BindPFlag(prefix+"_capitalize", cmd.Flags().Lookup("capitalize")))
+}
+
+// Generate generates password or passphrase using flags added with AddFlags
+func Generate(prefix string) ([]byte, error) {
+	if viper.GetBool(prefix + "_passphrase") {
+		return generator.Passphrase(&generator.PassphrasePolicy{
+			Words:      viper.GetInt(prefix + "_words"),
+			Separator:  viper.GetString(prefix + "_separator"),
+			Capitalize: viper.GetBool(prefix + "_capitalize"),
+		})
+	}
+
+	return generator.Password(&generator.Policy{
+		Length:           viper.GetInt(prefix + "_length"),
+		Lower:            viper.GetBool(prefix + "_lower"),
+		Upper:            viper.GetBool(prefix + "_upper"),
+		Digits:           viper.GetBool(prefix + "_digits"),
+		Symbols:          viper.GetBool(prefix + "_symbols"),
+		ExcludeAmbiguous: viper.GetBool(prefix + "_exclude_ambiguous"),
+		RequireEachClass: viper.GetBool(prefix + "_require_each_class"),
+	})
+}
+
+func init() {
+	root.AddCommand(generateCmd)
+
+	AddFlags(generateCmd, "generate")
+}
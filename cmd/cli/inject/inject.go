@@ -0,0 +1,34 @@
+// Package inject implements commands handing decrypted secrets to other programs:
+// in the environment of the command or rendered into its configuration files
+package inject
+
+import (
+	"context"
+
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// secretsClient is the part of the secrets client resolving references to secrets
+type secretsClient interface {
+	Get(id string) (*pb.Secret, error)
+	Select(selector string) ([]*pb.Secret, error)
+}
+
+// withAgent calls fn with the secrets client, the master password is asked for once if the agent is locked
+func withAgent(fn func(client secretsClient) error) error {
+	return cli.WithUnlock(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		if err != nil {
+			return err
+		}
+
+		return fn(client)
+	})
+}
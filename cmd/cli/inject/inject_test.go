@@ -0,0 +1,72 @@
+package inject
+
+import (
+	"fmt"
+	"sort"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/selector"
+)
+
+// fakeClient serves decrypted secrets by ID, Select matches labels as the agent does
+type fakeClient map[string]*pb.Secret
+
+func (c fakeClient) Get(id string) (*pb.Secret, error) {
+	secret, ok := c[id]
+	if !ok {
+		return nil, fmt.Errorf("secret %s not found", id)
+	}
+
+	return secret, nil
+}
+
+func (c fakeClient) Select(labels string) ([]*pb.Secret, error) {
+	labelSelector, err := selector.Parse(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	var selected []*pb.Secret
+	for _, secret := range c {
+		if labelSelector.Matches(secret.GetLabels()) {
+			selected = append(selected, secret)
+		}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].GetID() < selected[j].GetID()
+	})
+
+	return selected, nil
+}
+
+func newFakeClient() fakeClient {
+	return fakeClient{
+		"db": {
+			ID:     "db",
+			Labels: map[string]string{"app": "api", defaultNameLabel: "DB_PASSWORD"},
+			Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+				Username: "alice",
+				Password: []byte("s3cret"),
+			}},
+		},
+		"token": {
+			ID:     "token",
+			Labels: map[string]string{"app": "api", defaultNameLabel: "API_TOKEN"},
+			Data:   []byte("t0ken"),
+		},
+		"duplicate": {
+			ID:     "duplicate",
+			Labels: map[string]string{"app": "duplicate", defaultNameLabel: "DB_PASSWORD"},
+			Data:   []byte("other"),
+		},
+		"invalid": {
+			ID:     "invalid",
+			Labels: map[string]string{"app": "invalid", defaultNameLabel: "1_INVALID"},
+			Data:   []byte("invalid"),
+		},
+		"binary": {
+			ID:   "binary",
+			Data: []byte("bin\x00ary"),
+		},
+	}
+}
@@ -0,0 +1,219 @@
+package inject
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"os/signal"
+	"regexp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/cmd/root"
+	"github.com/go-rfe/gpwd/internal/output"
+	"github.com/go-rfe/gpwd/internal/payload"
+	"github.com/go-rfe/gpwd/internal/selector"
+)
+
+const defaultNameLabel = "env_var"
+
+var (
+	ErrMalformedMapping = errors.New("malformed secret mapping, should be in the form of NAME=<id> or NAME=<id>:<field>")
+
+	envName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+	// forwardedSignals are relayed to the command, the rest keep their default behaviour
+	forwardedSignals = []os.Signal{
+		syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP, syscall.SIGQUIT, syscall.SIGUSR1, syscall.SIGUSR2,
+	}
+)
+
+// runCmd represents the command for running programs with secrets in the environment
+var runCmd = &cobra.Command{
+	Use:   "run [flags] -- command [args...]",
+	Short: "run command with secrets from gpwd agent in the environment",
+	Long: `cli gets decrypted secrets from the agent and runs the command with them in the environment.
+Secrets are mapped to variables explicitly with --secret NAME=<id>, the main content of the secret
+(password, card number, note text, private key, file content or data) is used unless the field is given
+with --secret NAME=<id>:<field>. Every secret matching the --labels selector is mapped to the variable
+named by its env_var label (--nameLabel). Signals are forwarded to the command and its exit code is returned.
+Secrets are never written to disk`,
+	Args:   cobra.MinimumNArgs(1),
+	PreRun: cli.BindAgentFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		mappings, err := parseMappings(viper.GetStringSlice("run_secrets"))
+		cobra.CheckErr(err)
+
+		var values map[string][]byte
+		if len(mappings) > 0 || viper.GetString("run_labels") != "" {
+			cobra.CheckErr(withAgent(func(client secretsClient) error {
+				values, err = resolveEnv(client, mappings, viper.GetString("run_labels"), viper.GetString("run_name_label"))
+				return err
+			}))
+		}
+
+		env := os.Environ()
+		for name, value := range values {
+			env = append(env, name+"="+string(value))
+		}
+
+		code, err := runCommand(args[0], args[1:], env)
+		cobra.CheckErr(err)
+
+		os.Exit(code)
+	},
+}
+
+// mapping maps the field of the secret to the environment variable, the empty field is the main content
+type mapping struct {
+	name  string
+	id    string
+	field string
+}
+
+func parseMappings(specs []string) ([]mapping, error) {
+	mappings := make([]mapping, 0, len(specs))
+	for _, spec := range specs {
+		name, reference, found := strings.Cut(spec, "=")
+		if !found || reference == "" {
+			return nil, fmt.Errorf("%w: %q", ErrMalformedMapping, spec)
+		}
+
+		if !envName.MatchString(name) {
+			return nil, fmt.Errorf("invalid environment variable name %q", name)
+		}
+
+		id, field, _ := strings.Cut(reference, ":")
+		mappings = append(mappings, mapping{name: name, id: id, field: field})
+	}
+
+	return mappings, nil
+}
+
+// resolveEnv gets values of the environment variables, variables mapped explicitly override the selected ones
+func resolveEnv(client secretsClient, mappings []mapping, labels, nameLabel string) (map[string][]byte, error) {
+	values := make(map[string][]byte)
+
+	if labels != "" {
+		// Secrets without the label naming the variable aren't selected
+		labelSelector, err := selector.Parse(labels + "," + nameLabel)
+		if err != nil {
+			return nil, err
+		}
+
+		selected, err := client.Select(labelSelector.String())
+		if err != nil {
+			return nil, err
+		}
+
+		origins := make(map[string]string)
+		for _, secret := range selected {
+			name := secret.GetLabels()[nameLabel]
+			if !envName.MatchString(name) {
+				return nil, fmt.Errorf("secret %s has invalid environment variable name %q in %s label",
+					secret.GetID(), name, nameLabel)
+			}
+
+			if origin, ok := origins[name]; ok {
+				return nil, fmt.Errorf("secrets %s and %s are both mapped to %s", origin, secret.GetID(), name)
+			}
+			origins[name] = secret.GetID()
+
+			values[name] = payload.Content(secret)
+		}
+	}
+
+	for _, m := range mappings {
+		secret, err := client.Get(m.id)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get secret %s for %s: %w", m.id, m.name, err)
+		}
+
+		value := payload.Content(secret)
+		if m.field != "" {
+			value, err = output.Field(secret, m.field)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		values[m.name] = value
+	}
+
+	for name, value := range values {
+		if bytes.IndexByte(value, 0) >= 0 {
+			return nil, fmt.Errorf("value of %s contains NUL bytes and can't be passed in the environment", name)
+		}
+	}
+
+	return values, nil
+}
+
+// runCommand runs the command forwarding signals to it, the exit code of the command killed by the signal
+// is 128 plus the signal number as in shells
+func runCommand(name string, args, env []string) (int, error) {
+	cmd := exec.Command(name, args...)
+	cmd.Env = env
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	signals := make(chan os.Signal, len(forwardedSignals))
+	signal.Notify(signals, forwardedSignals...)
+	defer signal.Stop(signals)
+
+	if err := cmd.Start(); err != nil {
+		return 0, err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			select {
+			case sig := <-signals:
+				_ = cmd.Process.Signal(sig)
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	err := cmd.Wait()
+
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if status, ok := exitErr.Sys().(syscall.WaitStatus); ok && status.Signaled() {
+			return 128 + int(status.Signal()), nil
+		}
+
+		return exitErr.ExitCode(), nil
+	}
+
+	return 0, err
+}
+
+func init() {
+	root.AddCommand(runCmd)
+	cli.AddAgentFlags(runCmd, 10*time.Second)
+
+	// Flags after the command name belong to the command
+	runCmd.Flags().SetInterspersed(false)
+
+	runCmd.Flags().StringArray("secret", nil, "Environment variable mapped to the secret: NAME=<id> or NAME=<id>:<field>")
+	cobra.CheckErr(viper.BindPFlag("run_secrets", runCmd.Flags().Lookup("secret")))
+
+	runCmd.Flags().String("labels", "", "Label selector of secrets mapped to variables named by their label, e.g. app=api")
+	cobra.CheckErr(viper.BindPFlag("run_labels", runCmd.Flags().Lookup("labels")))
+
+	runCmd.Flags().String("nameLabel", defaultNameLabel, "Label naming the environment variable of the selected secret")
+	cobra.CheckErr(viper.BindPFlag("run_name_label", runCmd.Flags().Lookup("nameLabel")))
+}
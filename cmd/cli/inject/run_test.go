@@ -0,0 +1,139 @@
+package inject
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"syscall"
+	"testing"
+
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+func TestParseMappings(t *testing.T) {
+	tests := []struct {
+		name  string
+		specs []string
+		want  []mapping
+		err   error
+	}{
+		{
+			name:  "main content and field",
+			specs: []string{"DB_PASSWORD=db", "DB_USER=db:username"},
+			want: []mapping{
+				{name: "DB_PASSWORD", id: "db"},
+				{name: "DB_USER", id: "db", field: "username"},
+			},
+		},
+		{name: "no reference", specs: []string{"DB_PASSWORD"}, err: ErrMalformedMapping},
+		{name: "empty reference", specs: []string{"DB_PASSWORD="}, err: ErrMalformedMapping},
+		{name: "invalid name", specs: []string{"1_PASSWORD=db"}},
+		{name: "empty name", specs: []string{"=db"}},
+	}
+
+	for _, tt := range tests {
+		got, err := parseMappings(tt.specs)
+		if tt.want == nil {
+			if err == nil || tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("parseMappings(%s) error = %v, want %v", tt.name, err, tt.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("parseMappings(%s) error = %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseMappings(%s) = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestResolveEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		mappings []mapping
+		labels   string
+		want     map[string]string
+		err      error
+	}{
+		{
+			name:     "mapped explicitly",
+			mappings: []mapping{{name: "PASSWORD", id: "db"}, {name: "USER", id: "db", field: "username"}},
+			want:     map[string]string{"PASSWORD": "s3cret", "USER": "alice"},
+		},
+		{
+			name:   "selected by labels",
+			labels: "app=api",
+			want:   map[string]string{"DB_PASSWORD": "s3cret", "API_TOKEN": "t0ken"},
+		},
+		{
+			name:     "mapped explicitly over selected",
+			mappings: []mapping{{name: "API_TOKEN", id: "db", field: "username"}},
+			labels:   "app=api",
+			want:     map[string]string{"DB_PASSWORD": "s3cret", "API_TOKEN": "alice"},
+		},
+		{name: "selected to the same variable", labels: "app in (api,duplicate)"},
+		{name: "invalid variable name label", labels: "app=invalid"},
+		{name: "NUL bytes", mappings: []mapping{{name: "BINARY", id: "binary"}}},
+		{name: "missing secret", mappings: []mapping{{name: "MISSING", id: "missing"}}},
+		{
+			name:     "unknown field",
+			mappings: []mapping{{name: "UNKNOWN", id: "db", field: "unknown"}},
+			err:      output.ErrUnknownField,
+		},
+	}
+
+	client := newFakeClient()
+	for _, tt := range tests {
+		values, err := resolveEnv(client, tt.mappings, tt.labels, defaultNameLabel)
+		if tt.want == nil {
+			if err == nil || tt.err != nil && !errors.Is(err, tt.err) {
+				t.Errorf("resolveEnv(%s) error = %v, want %v", tt.name, err, tt.err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("resolveEnv(%s) error = %v", tt.name, err)
+			continue
+		}
+
+		got := make(map[string]string, len(values))
+		for name, value := range values {
+			got[name] = string(value)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("resolveEnv(%s) = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	tests := []struct {
+		name   string
+		script string
+		want   int
+	}{
+		{name: "success", script: `test "$GPWD_TEST" = value`, want: 0},
+		{name: "exit code", script: "exit 3", want: 3},
+		{name: "killed by signal", script: "kill -TERM $$", want: 128 + int(syscall.SIGTERM)},
+	}
+
+	env := append(os.Environ(), "GPWD_TEST=value")
+	for _, tt := range tests {
+		code, err := runCommand("sh", []string{"-c", tt.script}, env)
+		if err != nil {
+			t.Errorf("runCommand(%s) error = %v", tt.name, err)
+			continue
+		}
+		if code != tt.want {
+			t.Errorf("runCommand(%s) = %d, want %d", tt.name, code, tt.want)
+		}
+	}
+
+	if _, err := runCommand("gpwd-missing-command", nil, env); err == nil {
+		t.Error("runCommand(missing command) error = nil, want error")
+	}
+}
@@ -0,0 +1,177 @@
+package inject
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/cmd/root"
+	"github.com/go-rfe/gpwd/internal/output"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var ErrNoSecretsSelected = errors.New("no secrets match the selector")
+
+// injectCmd represents the command for rendering secrets into files
+var injectCmd = &cobra.Command{
+	Use:   "inject",
+	Short: "render template with secrets from gpwd agent",
+	Long: `cli renders the Go template resolving references to secrets with the agent:
+  {{ secret "<id>" }}                  the main content of the secret: password, card number, note text,
+                                       private key, file content or data
+  {{ secretField "<id>" "<field>" }}   the field of the secret as in gpwd secret get --field
+  {{ secretsByLabel "<selector>" }}    the list of secrets matching the label selector with the fields
+                                       shown by gpwd secret get -o template and the main content as .Value
+The output is written with 0600 permissions only if every reference is resolved`,
+	PreRun: cli.BindAgentFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		text, err := os.ReadFile(viper.GetString("inject_in"))
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(withAgent(func(client secretsClient) error {
+			return inject(client, filepath.Base(viper.GetString("inject_in")), string(text), viper.GetString("inject_out"))
+		}))
+	},
+}
+
+// inject renders the template into the output file, the file is left untouched if the rendering fails
+func inject(client secretsClient, name, text, out string) error {
+	rendered, err := render(client, name, text)
+	if err != nil {
+		return err
+	}
+
+	return writeFile(out, rendered)
+}
+
+// selectedSecret is the decrypted secret returned by secretsByLabel
+type selectedSecret struct {
+	output.Secret
+	// Value is the main content of the secret
+	Value string
+}
+
+// render renders the template, missing secrets, fields and map keys fail the rendering
+func render(client secretsClient, name, text string) ([]byte, error) {
+	cache := make(map[string]*pb.Secret)
+	get := func(id string) (*pb.Secret, error) {
+		if secret, ok := cache[id]; ok {
+			return secret, nil
+		}
+
+		secret, err := client.Get(id)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't get secret %s: %w", id, err)
+		}
+		cache[id] = secret
+
+		return secret, nil
+	}
+
+	tmpl, err := template.New(name).Option("missingkey=error").Funcs(template.FuncMap{
+		"secret": func(id string) (string, error) {
+			secret, err := get(id)
+			if err != nil {
+				return "", err
+			}
+
+			return string(payload.Content(secret)), nil
+		},
+		"secretField": func(id, field string) (string, error) {
+			secret, err := get(id)
+			if err != nil {
+				return "", err
+			}
+
+			value, err := output.Field(secret, field)
+			if err != nil {
+				return "", fmt.Errorf("secret %s: %w", id, err)
+			}
+
+			return string(value), nil
+		},
+		"secretsByLabel": func(selector string) ([]selectedSecret, error) {
+			secrets, err := client.Select(selector)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(secrets) == 0 {
+				return nil, fmt.Errorf("%w %q", ErrNoSecretsSelected, selector)
+			}
+
+			selected := make([]selectedSecret, 0, len(secrets))
+			for _, secret := range secrets {
+				selected = append(selected, selectedSecret{
+					Secret: output.NewDecryptedSecret(secret),
+					Value:  string(payload.Content(secret)),
+				})
+			}
+
+			return selected, nil
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, nil); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// writeFile replaces the file with the data readable by the owner only,
+// the data is written to a temporary file first, so the file is never partially written
+func writeFile(path string, data []byte) error {
+	file, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	if err := file.Chmod(0600); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Sync(); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func init() {
+	root.AddCommand(injectCmd)
+	cli.AddAgentFlags(injectCmd, 10*time.Second)
+
+	injectCmd.Flags().String("in", "", "Template file path")
+	cobra.CheckErr(viper.BindPFlag("inject_in", injectCmd.Flags().Lookup("in")))
+	cobra.CheckErr(injectCmd.MarkFlagRequired("in"))
+
+	injectCmd.Flags().String("out", "", "Output file path, the file is replaced")
+	cobra.CheckErr(viper.BindPFlag("inject_out", injectCmd.Flags().Lookup("out")))
+	cobra.CheckErr(injectCmd.MarkFlagRequired("out"))
+}
@@ -0,0 +1,132 @@
+package inject
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		want string
+	}{
+		{name: "secret", text: `password={{ secret "db" }}`, want: "password=s3cret"},
+		{name: "secretField", text: `user={{ secretField "db" "username" }}`, want: "user=alice"},
+		{
+			name: "secretsByLabel",
+			text: `{{ range secretsByLabel "app=api" }}{{ .Labels.env_var }}={{ .Value }};{{ end }}`,
+			want: "DB_PASSWORD=s3cret;API_TOKEN=t0ken;",
+		},
+	}
+
+	client := newFakeClient()
+	for _, tt := range tests {
+		got, err := render(client, tt.name, tt.text)
+		if err != nil {
+			t.Errorf("render(%s) error = %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("render(%s) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+// The output file is left as is if any reference isn't resolved
+func TestInjectUnresolved(t *testing.T) {
+	tests := []struct {
+		name string
+		text string
+		err  error
+	}{
+		{name: "missing secret", text: `{{ secret "missing" }}`},
+		{name: "unknown field", text: `{{ secretField "db" "unknown" }}`, err: output.ErrUnknownField},
+		{name: "no secrets selected", text: `{{ range secretsByLabel "app=missing" }}{{ .Value }}{{ end }}`, err: ErrNoSecretsSelected},
+		{name: "missing map key", text: `{{ range secretsByLabel "app=api" }}{{ .Labels.missing }}{{ end }}`},
+	}
+
+	client := newFakeClient()
+	for _, tt := range tests {
+		dir := t.TempDir()
+		out := filepath.Join(dir, "config")
+		if err := os.WriteFile(out, []byte("previous"), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		err := inject(client, tt.name, `password={{ secret "db" }}`+"\n"+tt.text, out)
+		if err == nil || tt.err != nil && !errors.Is(err, tt.err) {
+			t.Errorf("inject(%s) error = %v, want %v", tt.name, err, tt.err)
+		}
+
+		data, err := os.ReadFile(out)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(data) != "previous" {
+			t.Errorf("inject(%s) output = %q, want it untouched", tt.name, data)
+		}
+		assertFiles(t, dir, 1)
+	}
+}
+
+func TestWriteFile(t *testing.T) {
+	// The mode doesn't depend on the umask
+	defer syscall.Umask(syscall.Umask(0))
+
+	dir := t.TempDir()
+	out := filepath.Join(dir, "config")
+
+	if err := writeFile(out, []byte("created")); err != nil {
+		t.Fatalf("writeFile() error = %v", err)
+	}
+	assertFile(t, out, "created")
+
+	// The replaced file gets the same mode
+	if err := os.Chmod(out, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := writeFile(out, []byte("replaced")); err != nil {
+		t.Fatalf("writeFile() error = %v", err)
+	}
+	assertFile(t, out, "replaced")
+	assertFiles(t, dir, 1)
+}
+
+func assertFile(t *testing.T, path, want string) {
+	t.Helper()
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mode := info.Mode().Perm(); mode != 0600 {
+		t.Errorf("file mode = %v, want %v", mode, os.FileMode(0600))
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != want {
+		t.Errorf("file content = %q, want %q", data, want)
+	}
+}
+
+// assertFiles checks that no temporary files are left in the dir
+func assertFiles(t *testing.T, dir string, want int) {
+	t.Helper()
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != want {
+		t.Errorf("files in %s = %d, want %d", dir, len(entries), want)
+	}
+}
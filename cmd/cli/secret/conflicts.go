@@ -0,0 +1,106 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var resolutions = map[string]pb.Resolution{
+	"local":  pb.Resolution_KEEP_LOCAL,
+	"remote": pb.Resolution_KEEP_REMOTE,
+	"both":   pb.Resolution_KEEP_BOTH,
+}
+
+// conflictsCmd represents the conflicts command
+var conflictsCmd = &cobra.Command{
+	Use:   "conflicts",
+	Short: "list secrets changed both locally and on the server",
+	Long: `cli connects to the agent and list secrets changed both locally and on the server since the last sync.
+Such secrets aren't synced until the conflict is resolved with resolve command`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		conflicts, err := client.Conflicts()
+		cobra.CheckErr(err)
+
+		views := output.NewConflicts(conflicts)
+		cobra.CheckErr(printer.Print(os.Stdout, views, func(w io.Writer) error {
+			rows := make([][]string, 0, len(views))
+			for _, conflict := range views {
+				rows = append(rows, []string{conflict.ID,
+					conflict.Type,
+					conflictSide(conflict.Local),
+					conflictSide(conflict.Remote),
+					conflict.DetectedAt})
+			}
+
+			return output.WriteTable(w, []string{"ID", "Type", "Local", "Remote", "Detected At"}, rows)
+		}))
+	},
+}
+
+// conflictsResolveCmd represents the conflicts resolve command
+var conflictsResolveCmd = &cobra.Command{
+	Use:   "resolve",
+	Short: "resolve secret conflict using gpwd agent",
+	Long: `cli connects to the agent and resolves the conflict keeping the local side, the remote side
+or both of them, the local side is kept as a new secret then`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		resolution, ok := resolutions[viper.GetString("resolve_keep")]
+		if !ok {
+			cobra.CheckErr(fmt.Errorf("unknown resolution %q, use local, remote or both", viper.GetString("resolve_keep")))
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		id, copyID, err := client.ResolveConflict(viper.GetString("resolve_id"), resolution)
+		cobra.CheckErr(err)
+		cobra.CheckErr(printChanged(printer, changed{ID: id, CopyID: copyID}))
+	},
+}
+
+// conflictSide describes the change of the secret on one side of the conflict
+func conflictSide(secret output.Secret) string {
+	switch {
+	case secret.Deleted:
+		return "deleted"
+	case secret.UpdatedAt != "":
+		return "updated " + secret.UpdatedAt
+	default:
+		return "created " + secret.CreatedAt
+	}
+}
+
+func init() {
+	secretCmd.AddCommand(conflictsCmd)
+	conflictsCmd.AddCommand(conflictsResolveCmd)
+
+	conflictsResolveCmd.Flags().String("id", "", "Secret ID")
+	cobra.CheckErr(viper.BindPFlag("resolve_id", conflictsResolveCmd.Flags().Lookup("id")))
+
+	conflictsResolveCmd.Flags().String("keep", "", "Side of the conflict to keep: local, remote or both")
+	cobra.CheckErr(viper.BindPFlag("resolve_keep", conflictsResolveCmd.Flags().Lookup("keep")))
+}
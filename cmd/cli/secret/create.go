@@ -2,14 +2,16 @@ package secret
 
 import (
 	"context"
-	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/go-rfe/gpwd/cmd/cli/generate"
 	"github.com/go-rfe/gpwd/internal/client/secrets"
 	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
+	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
 // createCmd represents the create command
@@ -17,42 +19,57 @@ var createCmd = &cobra.Command{
 	Use:   "create",
 	Short: "create secret using gpwd agent",
 	Long: `cli connects to the agent and sends secret data securely.
-Data could be loaded from file or provided inline`,
+Data could be loaded from file or provided inline.
+Use subcommands to create typed secrets: login, card, note, ssh and file`,
 	Run: func(cmd *cobra.Command, args []string) {
 		var data []byte
 		var err error
 
 		dataFilePath := viper.GetString("create_data_file_path")
-		if dataFilePath != "" {
+		switch {
+		case viper.GetBool("create_generate"):
+			data, err = generate.Generate("create_generate")
+			cobra.CheckErr(err)
+		case dataFilePath != "":
 			file, err := os.ReadFile(dataFilePath)
 			cobra.CheckErr(err)
 
 			data = file
-		} else {
+		default:
 			data, err = encryption.AskForSecretInput("Please enter secret data inline:")
 			cobra.CheckErr(err)
 		}
 
-		labels := viper.GetStringSlice("create_labels")
+		createSecret(&pb.Secret{Data: data})
+	},
+}
+
+// createSecret sends the secret to the agent and prints its ID
+func createSecret(secret *pb.Secret) {
+	printer, err := output.New(viper.GetString("output"))
+	cobra.CheckErr(err)
 
-		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
-		defer cancel()
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+	defer cancel()
 
-		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
-		cobra.CheckErr(err)
+	client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+	cobra.CheckErr(err)
 
-		id, err := client.Create(data, labels)
-		cobra.CheckErr(err)
-		fmt.Println(id)
-	},
+	id, err := client.CreateSecret(secret, viper.GetStringSlice("create_labels"))
+	cobra.CheckErr(err)
+	cobra.CheckErr(printChanged(printer, changed{ID: id}))
 }
 
 func init() {
 	secretCmd.AddCommand(createCmd)
 
+	createCmd.Flags().Bool("generate", false, "Generate data instead of asking for it")
+	cobra.CheckErr(viper.BindPFlag("create_generate", createCmd.Flags().Lookup("generate")))
+	generate.AddFlags(createCmd, "create_generate")
+
 	createCmd.Flags().String("dataFromFile", "", "File path to get data from")
 	cobra.CheckErr(viper.BindPFlag("create_data_file_path", createCmd.Flags().Lookup("dataFromFile")))
 
-	createCmd.Flags().StringSlice("labels", nil, "Labels key=value, pairs")
-	cobra.CheckErr(viper.BindPFlag("create_labels", createCmd.Flags().Lookup("labels")))
+	createCmd.PersistentFlags().StringSlice("labels", nil, "Labels key=value, pairs")
+	cobra.CheckErr(viper.BindPFlag("create_labels", createCmd.PersistentFlags().Lookup("labels")))
 }
@@ -0,0 +1,45 @@
+package secret
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// createCardCmd represents the command for creating credit card secrets
+var createCardCmd = &cobra.Command{
+	Use:   "card",
+	Short: "create credit card secret using gpwd agent",
+	Long: `cli connects to the agent and sends credit card data securely.
+Card number and CVV are provided inline`,
+	Run: func(cmd *cobra.Command, args []string) {
+		number, err := encryption.AskForSecretInput("Please enter card number:")
+		cobra.CheckErr(err)
+
+		cvv, err := encryption.AskForSecretInput("Please enter CVV:")
+		cobra.CheckErr(err)
+
+		createSecret(&pb.Secret{
+			Payload: &pb.Secret_Card{
+				Card: &pb.CardPayload{
+					Holder: viper.GetString("create_card_holder"),
+					Number: number,
+					Expiry: viper.GetString("create_card_expiry"),
+					CVV:    cvv,
+				},
+			},
+		})
+	},
+}
+
+func init() {
+	createCmd.AddCommand(createCardCmd)
+
+	createCardCmd.Flags().String("holder", "", "Card holder name")
+	cobra.CheckErr(viper.BindPFlag("create_card_holder", createCardCmd.Flags().Lookup("holder")))
+
+	createCardCmd.Flags().String("expiry", "", "Card expiry date, MM/YY")
+	cobra.CheckErr(viper.BindPFlag("create_card_expiry", createCardCmd.Flags().Lookup("expiry")))
+}
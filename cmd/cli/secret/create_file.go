@@ -0,0 +1,67 @@
+package secret
+
+import (
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// createFileCmd represents the command for creating binary file secrets
+var createFileCmd = &cobra.Command{
+	Use:   "file",
+	Short: "create file secret using gpwd agent",
+	Long: `cli connects to the agent and sends the file securely.
+MIME type is detected from the file extension or content unless set.
+Files up to 3 MiB are accepted, so the secret fits the sync with the server`,
+	Run: func(cmd *cobra.Command, args []string) {
+		filePath := viper.GetString("create_file_path")
+		if filePath == "" {
+			cobra.CheckErr("file path is required")
+		}
+
+		info, err := os.Stat(filePath)
+		cobra.CheckErr(err)
+		if info.Size() > payload.MaxFileSize {
+			cobra.CheckErr(fmt.Sprintf("file is larger than %d MiB", payload.MaxFileSize>>20))
+		}
+
+		content, err := os.ReadFile(filePath)
+		cobra.CheckErr(err)
+
+		mimeType := viper.GetString("create_file_mime_type")
+		if mimeType == "" {
+			mimeType = mime.TypeByExtension(filepath.Ext(filePath))
+		}
+		if mimeType == "" {
+			mimeType = http.DetectContentType(content)
+		}
+
+		createSecret(&pb.Secret{
+			Payload: &pb.Secret_File{
+				File: &pb.FilePayload{
+					Name:     filepath.Base(filePath),
+					MIMEType: mimeType,
+					Content:  content,
+				},
+			},
+		})
+	},
+}
+
+func init() {
+	createCmd.AddCommand(createFileCmd)
+
+	createFileCmd.Flags().String("path", "", "File path")
+	cobra.CheckErr(viper.BindPFlag("create_file_path", createFileCmd.Flags().Lookup("path")))
+
+	createFileCmd.Flags().String("mimeType", "", "File MIME type")
+	cobra.CheckErr(viper.BindPFlag("create_file_mime_type", createFileCmd.Flags().Lookup("mimeType")))
+}
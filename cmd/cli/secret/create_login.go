@@ -0,0 +1,99 @@
+package secret
+
+import (
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli/generate"
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/otp"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// createLoginCmd represents the command for creating login secrets
+var createLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "create login secret using gpwd agent",
+	Long: `cli connects to the agent and sends login credentials securely.
+Password and TOTP seed are provided inline`,
+	Run: func(cmd *cobra.Command, args []string) {
+		var password []byte
+		var err error
+
+		if viper.GetBool("create_login_generate") {
+			password, err = generate.Generate("create_login_generate")
+		} else {
+			password, err = encryption.AskForSecretInput("Please enter password:")
+		}
+		cobra.CheckErr(err)
+
+		var totpSeed []byte
+		if viper.GetBool("create_login_totp") {
+			seed, err := encryption.AskForSecretInput("Please enter otpauth:// URI or base32 TOTP seed:")
+			cobra.CheckErr(err)
+
+			totpSeed, err = otpSeed(string(seed))
+			cobra.CheckErr(err)
+		}
+
+		createSecret(&pb.Secret{
+			Payload: &pb.Secret_Login{
+				Login: &pb.LoginPayload{
+					Username: viper.GetString("create_login_username"),
+					Password: password,
+					URIs:     viper.GetStringSlice("create_login_uris"),
+					TOTPSeed: totpSeed,
+				},
+			},
+		})
+	},
+}
+
+// otpSeed validates the seed, base32 secret gets parameters from the flags
+func otpSeed(seed string) ([]byte, error) {
+	key, err := otp.Parse(seed)
+	if err != nil {
+		return nil, err
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(seed), "otpauth://") {
+		key.Algorithm = strings.ToUpper(viper.GetString("create_login_totp_algorithm"))
+		key.Digits = viper.GetInt("create_login_totp_digits")
+		key.Period = viper.GetUint64("create_login_totp_period")
+		key.Label = viper.GetString("create_login_username")
+
+		if err := key.Validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return []byte(key.URI()), nil
+}
+
+func init() {
+	createCmd.AddCommand(createLoginCmd)
+
+	createLoginCmd.Flags().String("username", "", "User name")
+	cobra.CheckErr(viper.BindPFlag("create_login_username", createLoginCmd.Flags().Lookup("username")))
+
+	createLoginCmd.Flags().StringSlice("uri", nil, "URIs the login is used for")
+	cobra.CheckErr(viper.BindPFlag("create_login_uris", createLoginCmd.Flags().Lookup("uri")))
+
+	createLoginCmd.Flags().Bool("generate", false, "Generate password instead of asking for it")
+	cobra.CheckErr(viper.BindPFlag("create_login_generate", createLoginCmd.Flags().Lookup("generate")))
+	generate.AddFlags(createLoginCmd, "create_login_generate")
+
+	createLoginCmd.Flags().Bool("totp", false, "Ask for otpauth:// URI or base32 TOTP seed")
+	cobra.CheckErr(viper.BindPFlag("create_login_totp", createLoginCmd.Flags().Lookup("totp")))
+
+	createLoginCmd.Flags().String("totpAlgorithm", otp.AlgorithmSHA1, "TOTP algorithm of base32 seed, SHA1, SHA256 or SHA512")
+	cobra.CheckErr(viper.BindPFlag("create_login_totp_algorithm", createLoginCmd.Flags().Lookup("totpAlgorithm")))
+
+	createLoginCmd.Flags().Int("totpDigits", otp.DefaultDigits, "TOTP digits of base32 seed")
+	cobra.CheckErr(viper.BindPFlag("create_login_totp_digits", createLoginCmd.Flags().Lookup("totpDigits")))
+
+	createLoginCmd.Flags().Uint64("totpPeriod", otp.DefaultPeriod, "TOTP period in seconds of base32 seed")
+	cobra.CheckErr(viper.BindPFlag("create_login_totp_period", createLoginCmd.Flags().Lookup("totpPeriod")))
+}
@@ -0,0 +1,47 @@
+package secret
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// createNoteCmd represents the command for creating secure notes
+var createNoteCmd = &cobra.Command{
+	Use:   "note",
+	Short: "create secure note using gpwd agent",
+	Long: `cli connects to the agent and sends the note securely.
+Text could be loaded from file or provided inline`,
+	Run: func(cmd *cobra.Command, args []string) {
+		var text []byte
+		var err error
+
+		textFilePath := viper.GetString("create_note_file_path")
+		if textFilePath != "" {
+			text, err = os.ReadFile(textFilePath)
+			cobra.CheckErr(err)
+		} else {
+			text, err = encryption.AskForSecretInput("Please enter note text inline:")
+			cobra.CheckErr(err)
+		}
+
+		createSecret(&pb.Secret{
+			Payload: &pb.Secret_Note{
+				Note: &pb.NotePayload{
+					Text: text,
+				},
+			},
+		})
+	},
+}
+
+func init() {
+	createCmd.AddCommand(createNoteCmd)
+
+	createNoteCmd.Flags().String("textFromFile", "", "File path to get text from")
+	cobra.CheckErr(viper.BindPFlag("create_note_file_path", createNoteCmd.Flags().Lookup("textFromFile")))
+}
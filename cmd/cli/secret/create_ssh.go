@@ -0,0 +1,57 @@
+package secret
+
+import (
+	"errors"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// createSSHCmd represents the command for creating SSH key secrets
+var createSSHCmd = &cobra.Command{
+	Use:   "ssh",
+	Short: "create SSH key secret using gpwd agent",
+	Long: `cli connects to the agent and sends SSH key pair securely.
+Public key is read from the private key path with .pub suffix unless set`,
+	Run: func(cmd *cobra.Command, args []string) {
+		privateKeyPath := viper.GetString("create_ssh_private_key_path")
+		if privateKeyPath == "" {
+			cobra.CheckErr("private key path is required")
+		}
+
+		privateKey, err := os.ReadFile(privateKeyPath)
+		cobra.CheckErr(err)
+
+		publicKeyPath := viper.GetString("create_ssh_public_key_path")
+		if publicKeyPath == "" {
+			publicKeyPath = privateKeyPath + ".pub"
+		}
+
+		publicKey, err := os.ReadFile(publicKeyPath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			cobra.CheckErr(err)
+		}
+
+		createSecret(&pb.Secret{
+			Payload: &pb.Secret_SshKey{
+				SshKey: &pb.SSHKeyPayload{
+					PublicKey:  publicKey,
+					PrivateKey: privateKey,
+				},
+			},
+		})
+	},
+}
+
+func init() {
+	createCmd.AddCommand(createSSHCmd)
+
+	createSSHCmd.Flags().String("privateKey", "", "Private key file path")
+	cobra.CheckErr(viper.BindPFlag("create_ssh_private_key_path", createSSHCmd.Flags().Lookup("privateKey")))
+
+	createSSHCmd.Flags().String("publicKey", "", "Public key file path")
+	cobra.CheckErr(viper.BindPFlag("create_ssh_public_key_path", createSSHCmd.Flags().Lookup("publicKey")))
+}
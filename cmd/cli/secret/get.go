@@ -2,16 +2,18 @@ package secret
 
 import (
 	"context"
-	"encoding/json"
-	"fmt"
+	"io"
 	"os"
-	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/go-rfe/gpwd/cmd/cli"
 	"github.com/go-rfe/gpwd/internal/client/secrets"
 	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
 // getCmd represents the create command
@@ -20,71 +22,72 @@ var getCmd = &cobra.Command{
 	Short: "get secret from gpwd agent",
 	Long:  `cli connects to the agent and gets data by ID`,
 	Run: func(cmd *cobra.Command, args []string) {
-		var password []byte
-
-		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
-		defer cancel()
-
-		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
-		cobra.CheckErr(err)
-
-		secret, err := client.Get(viper.GetString("get_id"))
+		printer, err := output.New(viper.GetString("output"))
 		cobra.CheckErr(err)
 
-		if viper.GetString("master_password") == "" {
-			password, err = encryption.AskForSecretInput("Please type your master password:")
-			cobra.CheckErr(err)
-		} else {
-			password = []byte(viper.GetString("master_password"))
+		secret, err := getSecret(viper.GetString("get_id"))
+		if secrets.IsLocked(err) {
+			cobra.CheckErr(cli.UnlockAgent())
+			secret, err = getSecret(viper.GetString("get_id"))
 		}
-
-		_, decrypt, err := encryption.GetCrypto(password)
 		cobra.CheckErr(err)
 
-		var labels []byte
-		if secret.GetLabels() != nil {
-			labels, err = json.Marshal(secret.GetLabels())
+		if field := viper.GetString("get_field"); field != "" {
+			value, err := output.Field(secret, field)
 			cobra.CheckErr(err)
-		}
-
-		var decryptedData []byte
 
-		if secret.GetData() != nil {
-			decryptedData, err = decrypt(secret.GetData())
+			_, err = os.Stdout.Write(value)
 			cobra.CheckErr(err)
+
+			return
 		}
 
 		dataFilePath := viper.GetString("data_file_path")
 		if dataFilePath != "" {
-			err := os.WriteFile(dataFilePath, decryptedData, 0600)
-			cobra.CheckErr(err)
-		} else {
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.Escape)
-			_, err = fmt.Fprintln(w, "ID", "\t", "Labels", "\t", "Created At", "\t", "Updated At", "\t", "Deleted At", "\t", "Data")
+			err := os.WriteFile(dataFilePath, payload.Content(secret), 0600)
 			cobra.CheckErr(err)
 
-			createdAt := ""
-			if secret.CreatedAt != nil {
-				createdAt = secret.CreatedAt.AsTime().String()
+			return
+		}
+
+		cobra.CheckErr(printer.Print(os.Stdout, output.NewDecryptedSecret(secret), func(w io.Writer) error {
+			view := output.NewSecret(secret)
+
+			labels, err := formatLabels(view.Labels)
+			if err != nil {
+				return err
 			}
 
-			updatedAt := ""
-			if secret.UpdatedAt != nil {
-				updatedAt = secret.UpdatedAt.AsTime().String()
+			data := ""
+			if view.Type == payload.TypeData {
+				data = encryption.ToBase64(secret.GetData())
 			}
 
-			deletedAt := ""
-			if secret.DeletedAt != nil {
-				deletedAt = secret.DeletedAt.AsTime().String()
+			err = output.WriteTable(w,
+				[]string{"ID", "Type", "Labels", "Created At", "Updated At", "Deleted At", "Data"},
+				[][]string{{view.ID, view.Type, labels, view.CreatedAt, view.UpdatedAt, view.DeletedAt, data}})
+			if err != nil {
+				return err
 			}
 
-			_, err = fmt.Fprintln(w, secret.GetID(), "\t", string(labels), "\t", createdAt, "\t", updatedAt, "\t", deletedAt, "\t", encryption.ToBase64(decryptedData))
-			cobra.CheckErr(err)
-			cobra.CheckErr(w.Flush())
-		}
+			return printPayload(w, secret)
+		}))
 	},
 }
 
+// getSecret gets the decrypted secret from the agent
+func getSecret(id string) (*pb.Secret, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+	defer cancel()
+
+	client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+	if err != nil {
+		return nil, err
+	}
+
+	return client.Get(id)
+}
+
 func init() {
 	secretCmd.AddCommand(getCmd)
 
@@ -93,4 +96,10 @@ func init() {
 
 	getCmd.Flags().String("dataFilePath", "", "File path to save data to")
 	cobra.CheckErr(viper.BindPFlag("data_file_path", getCmd.Flags().Lookup("dataFilePath")))
+
+	getCmd.Flags().String("field", "",
+		"Print the single decrypted field as is, e.g. password, username, number, text, content or labels.<key>")
+	cobra.CheckErr(viper.BindPFlag("get_field", getCmd.Flags().Lookup("field")))
+
+	getCmd.MarkFlagsMutuallyExclusive("field", "dataFilePath")
 }
@@ -0,0 +1,60 @@
+package secret
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "list secret versions using gpwd agent",
+	Long:  `cli connects to the agent and lists prior versions of the secret by ID`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		versions, err := client.History(viper.GetString("history_id"))
+		cobra.CheckErr(err)
+
+		views := output.NewVersions(versions)
+		cobra.CheckErr(printer.Print(os.Stdout, views, func(w io.Writer) error {
+			rows := make([][]string, 0, len(views))
+			for _, version := range views {
+				labels, err := formatLabels(version.Secret.Labels)
+				if err != nil {
+					return err
+				}
+
+				rows = append(rows, []string{strconv.FormatUint(version.Version, 10),
+					version.Secret.Type,
+					labels,
+					version.Secret.UpdatedAt,
+					version.ArchivedAt})
+			}
+
+			return output.WriteTable(w, []string{"Version", "Type", "Labels", "Updated At", "Archived At"}, rows)
+		}))
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(historyCmd)
+
+	historyCmd.Flags().String("id", "", "Secret ID")
+	cobra.CheckErr(viper.BindPFlag("history_id", historyCmd.Flags().Lookup("id")))
+}
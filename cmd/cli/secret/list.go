@@ -4,55 +4,126 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
-	"text/tabwriter"
+	"strconv"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
+var sortKeys = map[string]pb.SortBy{
+	"":        pb.SortBy_SORT_UNSPECIFIED,
+	"created": pb.SortBy_SORT_CREATED_AT,
+	"updated": pb.SortBy_SORT_UPDATED_AT,
+}
+
 // listCmd represents the create command
 var listCmd = &cobra.Command{
 	Use:   "list",
 	Short: "list secrets using gpwd agent",
-	Long:  `cli connects to the agent and list secrets`,
+	Long: `cli connects to the agent and list secrets matching the label selector, e.g.
+env=prod,team!=infra,app in (api,web),owner,!temp`,
 	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		sortBy, ok := sortKeys[viper.GetString("list_sort_by")]
+		if !ok {
+			cobra.CheckErr(fmt.Errorf("unknown sort key %q, use created or updated", viper.GetString("list_sort_by")))
+		}
+
 		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
 		defer cancel()
 
 		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
 		cobra.CheckErr(err)
 
-		secrets, err := client.List()
-		cobra.CheckErr(err)
-
-		w := tabwriter.NewWriter(os.Stdout, 0, 0, 0, ' ', tabwriter.Escape)
-		_, err = fmt.Fprintln(w, "ID", "\t", "Labels", "\t", "Created At", "\t", "Updated At")
+		offset, limit := viper.GetUint32("list_offset"), viper.GetUint32("list_limit")
+		secrets, total, err := client.Query(&pb.ListSecretsRequest{
+			Selector:   viper.GetString("list_selector"),
+			SortBy:     sortBy,
+			Descending: viper.GetBool("list_descending"),
+			Offset:     offset,
+			Limit:      limit,
+		})
 		cobra.CheckErr(err)
 
-		var labels []byte
-		for _, secret := range secrets {
-			if secret.Labels != nil {
-				labels, err = json.Marshal(secret.Labels)
-				cobra.CheckErr(err)
+		cobra.CheckErr(printer.Print(os.Stdout, output.NewSecrets(secrets), func(w io.Writer) error {
+			if err := printSecrets(w, secrets, viper.GetBool("list_show_sync")); err != nil {
+				return err
 			}
 
-			updatedAt := ""
-			if secret.UpdatedAt != nil {
-				updatedAt = secret.GetUpdatedAt().AsTime().String()
+			if limit > 0 {
+				_, err := fmt.Fprintf(w, "Showing %d of %d secrets from offset %d\n", len(secrets), total, offset)
+				return err
 			}
-			_, err = fmt.Fprintln(w, secret.ID, "\t",
-				string(labels), "\t",
-				secret.GetCreatedAt().AsTime().String(), "\t",
-				updatedAt)
-			cobra.CheckErr(err)
-		}
-		cobra.CheckErr(w.Flush())
+
+			return nil
+		}))
 	},
 }
 
+// printSecrets prints the table of secrets, the synced column is added on request
+func printSecrets(w io.Writer, secrets []*pb.Secret, showSync bool) error {
+	header := []string{"ID", "Type", "Labels", "Created At", "Updated At"}
+	if showSync {
+		header = append(header, "Synced")
+	}
+
+	rows := make([][]string, 0, len(secrets))
+	for _, secret := range output.NewSecrets(secrets) {
+		labels, err := formatLabels(secret.Labels)
+		if err != nil {
+			return err
+		}
+
+		row := []string{secret.ID, secret.Type, labels, secret.CreatedAt, secret.UpdatedAt}
+		if showSync {
+			row = append(row, strconv.FormatBool(secret.Synced))
+		}
+		rows = append(rows, row)
+	}
+
+	return output.WriteTable(w, header, rows)
+}
+
+// formatLabels formats labels as JSON for the table column, no labels give the empty column
+func formatLabels(labels map[string]string) (string, error) {
+	if len(labels) == 0 {
+		return "", nil
+	}
+
+	data, err := json.Marshal(labels)
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
 func init() {
 	secretCmd.AddCommand(listCmd)
+
+	listCmd.Flags().Bool("showSync", false, "Mark secrets not synced with the server yet")
+	cobra.CheckErr(viper.BindPFlag("list_show_sync", listCmd.Flags().Lookup("showSync")))
+
+	listCmd.Flags().String("selector", "", "Label selector, e.g. env=prod,app in (api,web)")
+	cobra.CheckErr(viper.BindPFlag("list_selector", listCmd.Flags().Lookup("selector")))
+
+	listCmd.Flags().String("sortBy", "", "Sort secrets by created or updated time instead of the creation order")
+	cobra.CheckErr(viper.BindPFlag("list_sort_by", listCmd.Flags().Lookup("sortBy")))
+
+	listCmd.Flags().Bool("descending", false, "List secrets in descending order")
+	cobra.CheckErr(viper.BindPFlag("list_descending", listCmd.Flags().Lookup("descending")))
+
+	listCmd.Flags().Uint32("offset", 0, "Number of secrets to skip")
+	cobra.CheckErr(viper.BindPFlag("list_offset", listCmd.Flags().Lookup("offset")))
+
+	listCmd.Flags().Uint32("limit", 0, "Maximum number of secrets to list, 0 lists all secrets")
+	cobra.CheckErr(viper.BindPFlag("list_limit", listCmd.Flags().Lookup("limit")))
 }
@@ -0,0 +1,71 @@
+package secret
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// otpCmd represents the otp command
+var otpCmd = &cobra.Command{
+	Use:   "otp",
+	Short: "get one-time password from gpwd agent",
+	Long: `cli connects to the agent and gets the current TOTP or the next HOTP code
+of the login secret by ID. The seed never leaves the agent`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		code, remaining, err := getOTP(viper.GetString("otp_id"))
+		if secrets.IsLocked(err) {
+			cobra.CheckErr(cli.UnlockAgent())
+			code, remaining, err = getOTP(viper.GetString("otp_id"))
+		}
+		cobra.CheckErr(err)
+
+		result := otpCode{Code: code, Remaining: int64(remaining / time.Second)}
+		cobra.CheckErr(printer.Print(os.Stdout, result, func(w io.Writer) error {
+			if remaining > 0 {
+				_, err := fmt.Fprintf(w, "%s\t%ds remaining\n", code, result.Remaining)
+				return err
+			}
+
+			_, err := fmt.Fprintln(w, code)
+			return err
+		}))
+	},
+}
+
+// otpCode is the one-time password, the remaining validity is set for TOTP codes only
+type otpCode struct {
+	Code      string `json:"code" yaml:"code"`
+	Remaining int64  `json:"remaining_seconds,omitempty" yaml:"remaining_seconds,omitempty"`
+}
+
+func getOTP(id string) (string, time.Duration, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+	defer cancel()
+
+	client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+	if err != nil {
+		return "", 0, err
+	}
+
+	return client.OTP(id)
+}
+
+func init() {
+	secretCmd.AddCommand(otpCmd)
+
+	otpCmd.Flags().String("id", "", "Secret ID")
+	cobra.CheckErr(viper.BindPFlag("otp_id", otpCmd.Flags().Lookup("id")))
+}
@@ -0,0 +1,103 @@
+package secret
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// payloadFields returns the typed payload as field name and value pairs
+func payloadFields(secret *pb.Secret) [][2]string {
+	switch payload.Type(secret) {
+	case payload.TypeLogin:
+		login := secret.GetLogin()
+		return [][2]string{
+			{"Username", login.GetUsername()},
+			{"Password", string(login.GetPassword())},
+			{"URIs", strings.Join(login.GetURIs(), ",")},
+			{"TOTP", otpStatus(login)},
+		}
+	case payload.TypeCard:
+		card := secret.GetCard()
+		return [][2]string{
+			{"Holder", card.GetHolder()},
+			{"Number", string(card.GetNumber())},
+			{"Expiry", card.GetExpiry()},
+			{"CVV", string(card.GetCVV())},
+		}
+	case payload.TypeNote:
+		return [][2]string{
+			{"Text", string(secret.GetNote().GetText())},
+		}
+	case payload.TypeSSHKey:
+		sshKey := secret.GetSshKey()
+		return [][2]string{
+			{"Public Key", strings.TrimSpace(string(sshKey.GetPublicKey()))},
+			{"Private Key", encryption.ToBase64(sshKey.GetPrivateKey())},
+		}
+	case payload.TypeFile:
+		file := secret.GetFile()
+		return [][2]string{
+			{"Name", file.GetName()},
+			{"MIME Type", file.GetMIMEType()},
+			{"Size", fmt.Sprint(len(file.GetContent()))},
+		}
+	default:
+		return nil
+	}
+}
+
+func otpStatus(login *pb.LoginPayload) string {
+	if len(login.GetTOTPSeed()) == 0 {
+		return ""
+	}
+
+	return "use gpwd secret otp to get the code"
+}
+
+// printPayload renders the typed payload, one field per line
+func printPayload(out io.Writer, secret *pb.Secret) error {
+	fields := payloadFields(secret)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 1, ' ', 0)
+	for _, field := range fields {
+		if _, err := fmt.Fprintf(w, "%s:\t%s\n", field[0], field[1]); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// changed is the result of commands changing the secret
+type changed struct {
+	ID string `json:"id" yaml:"id"`
+	// CopyID is the ID of the local side of the conflict kept as a new secret
+	CopyID string `json:"copy_id,omitempty" yaml:"copy_id,omitempty"`
+}
+
+// printChanged prints IDs of the changed secret, one per line in the table format
+func printChanged(printer *output.Printer, result changed) error {
+	return printer.Print(os.Stdout, result, func(w io.Writer) error {
+		if _, err := fmt.Fprintln(w, result.ID); err != nil {
+			return err
+		}
+
+		if result.CopyID != "" {
+			_, err := fmt.Fprintln(w, result.CopyID)
+			return err
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,43 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// restoreCmd represents the restore command
+var restoreCmd = &cobra.Command{
+	Use:   "restore",
+	Short: "restore secret version using gpwd agent",
+	Long: `cli connects to the agent and makes the prior version of the secret current.
+The current version is kept in the history`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		id, err := client.Restore(viper.GetString("restore_id"), viper.GetUint64("restore_version"))
+		cobra.CheckErr(err)
+		cobra.CheckErr(printChanged(printer, changed{ID: id}))
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(restoreCmd)
+
+	restoreCmd.Flags().String("id", "", "Secret ID")
+	cobra.CheckErr(viper.BindPFlag("restore_id", restoreCmd.Flags().Lookup("id")))
+
+	restoreCmd.Flags().Uint64("version", 0, "Secret version to restore")
+	cobra.CheckErr(viper.BindPFlag("restore_version", restoreCmd.Flags().Lookup("version")))
+}
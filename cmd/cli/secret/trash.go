@@ -0,0 +1,61 @@
+package secret
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// trashCmd represents the trash command
+var trashCmd = &cobra.Command{
+	Use:   "trash",
+	Short: "manage deleted secrets",
+	Long: `Deleted secrets are kept in the trash until purged by the agent and the server.
+Use undelete command to restore a secret from the trash`,
+}
+
+// trashListCmd represents the trash list command
+var trashListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "list deleted secrets using gpwd agent",
+	Long:  `cli connects to the agent and list deleted secrets`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		secrets, err := client.Trash()
+		cobra.CheckErr(err)
+
+		views := output.NewSecrets(secrets)
+		cobra.CheckErr(printer.Print(os.Stdout, views, func(w io.Writer) error {
+			rows := make([][]string, 0, len(views))
+			for _, secret := range views {
+				labels, err := formatLabels(secret.Labels)
+				if err != nil {
+					return err
+				}
+
+				rows = append(rows, []string{secret.ID, secret.Type, labels, secret.DeletedAt})
+			}
+
+			return output.WriteTable(w, []string{"ID", "Type", "Labels", "Deleted At"}, rows)
+		}))
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(trashCmd)
+	trashCmd.AddCommand(trashListCmd)
+}
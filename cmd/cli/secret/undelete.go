@@ -0,0 +1,39 @@
+package secret
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// undeleteCmd represents the undelete command
+var undeleteCmd = &cobra.Command{
+	Use:   "undelete",
+	Short: "restore deleted secret using gpwd agent",
+	Long:  `cli connects to the agent and moves the secret out of the trash`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		id, err := client.Undelete(viper.GetString("undelete_id"))
+		cobra.CheckErr(err)
+		cobra.CheckErr(printChanged(printer, changed{ID: id}))
+	},
+}
+
+func init() {
+	secretCmd.AddCommand(undeleteCmd)
+
+	undeleteCmd.Flags().String("id", "", "Secret ID")
+	cobra.CheckErr(viper.BindPFlag("undelete_id", undeleteCmd.Flags().Lookup("id")))
+}
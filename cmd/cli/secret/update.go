@@ -2,14 +2,15 @@ package secret
 
 import (
 	"context"
-	"fmt"
 	"os"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/go-rfe/gpwd/cmd/cli/generate"
 	"github.com/go-rfe/gpwd/internal/client/secrets"
 	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
 )
 
 // updateCmd represents the create command
@@ -17,18 +18,25 @@ var updateCmd = &cobra.Command{
 	Use:   "update",
 	Short: "update secret using gpwd agent",
 	Long: `cli connects to the agent and sends secret data securely.
-Data could be loaded from file or provided inline.`,
+Data could be loaded from file or provided inline. The data of the typed secret replaces its main content:
+the password of the login, the number of the card, the text of the note, the private SSH key or the file content.`,
 	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
 		var data []byte
-		var err error
 
 		dataFilePath := viper.GetString("update_data_file_path")
-		if dataFilePath != "" {
+		switch {
+		case viper.GetBool("update_generate"):
+			data, err = generate.Generate("update_generate")
+			cobra.CheckErr(err)
+		case dataFilePath != "":
 			file, err := os.ReadFile(dataFilePath)
 			cobra.CheckErr(err)
 
 			data = file
-		} else {
+		default:
 			data, err = encryption.AskForSecretInput("Please enter secret data inline:")
 			cobra.CheckErr(err)
 		}
@@ -43,7 +51,7 @@ Data could be loaded from file or provided inline.`,
 
 		id, err := client.Update(viper.GetString("update_id"), data, labels)
 		cobra.CheckErr(err)
-		fmt.Println(id)
+		cobra.CheckErr(printChanged(printer, changed{ID: id}))
 	},
 }
 
@@ -53,6 +61,10 @@ func init() {
 	updateCmd.Flags().String("id", "", "Secret ID")
 	cobra.CheckErr(viper.BindPFlag("update_id", updateCmd.Flags().Lookup("id")))
 
+	updateCmd.Flags().Bool("generate", false, "Generate data instead of asking for it")
+	cobra.CheckErr(viper.BindPFlag("update_generate", updateCmd.Flags().Lookup("generate")))
+	generate.AddFlags(updateCmd, "update_generate")
+
 	updateCmd.Flags().String("dataFromFile", "", "File path to get data from")
 	cobra.CheckErr(viper.BindPFlag("update_data_file_path", updateCmd.Flags().Lookup("dataFromFile")))
 
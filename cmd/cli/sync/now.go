@@ -0,0 +1,30 @@
+package sync
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	agentclient "github.com/go-rfe/gpwd/internal/client/agent"
+)
+
+// nowCmd represents the command for syncing the agent right away
+var nowCmd = &cobra.Command{
+	Use:   "now",
+	Short: "sync gpwd agent with the server right away",
+	Long:  `cli connects to the agent and waits until the agent syncs with the server`,
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := agentclient.NewAgentClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		cobra.CheckErr(client.SyncNow())
+	},
+}
+
+func init() {
+	syncCmd.AddCommand(nowCmd)
+}
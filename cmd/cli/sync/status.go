@@ -0,0 +1,70 @@
+package sync
+
+import (
+	"context"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	agentclient "github.com/go-rfe/gpwd/internal/client/agent"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// statusCmd represents the command for showing the agent sync status
+var statusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "show gpwd agent sync status",
+	Long:  `cli connects to the agent and shows the last sync outcome and local changes waiting for sync`,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := agentclient.NewAgentClient(ctx, viper.GetString("socket_path"))
+		cobra.CheckErr(err)
+
+		status, err := client.SyncStatus()
+		cobra.CheckErr(err)
+
+		view := syncStatus{
+			ServerAddress: status.GetServerAddress(),
+			Watching:      status.GetWatching(),
+			LastSyncAt:    output.Time(status.GetLastSyncAt()),
+			LastError:     status.GetLastError(),
+			LastErrorAt:   output.Time(status.GetLastErrorAt()),
+			Pending:       status.GetPending(),
+			Conflicts:     status.GetConflicts(),
+		}
+		cobra.CheckErr(printer.Print(os.Stdout, view, func(w io.Writer) error {
+			return output.WriteTable(w, nil, [][]string{
+				{"Server", view.ServerAddress},
+				{"Watching", strconv.FormatBool(view.Watching)},
+				{"Last Sync At", view.LastSyncAt},
+				{"Last Error", view.LastError},
+				{"Last Error At", view.LastErrorAt},
+				{"Pending", strconv.FormatUint(view.Pending, 10)},
+				{"Conflicts", strconv.FormatUint(view.Conflicts, 10)},
+			})
+		}))
+	},
+}
+
+// syncStatus is the stable view of the agent sync status
+type syncStatus struct {
+	ServerAddress string `json:"server_address" yaml:"server_address"`
+	Watching      bool   `json:"watching" yaml:"watching"`
+	LastSyncAt    string `json:"last_sync_at,omitempty" yaml:"last_sync_at,omitempty"`
+	LastError     string `json:"last_error,omitempty" yaml:"last_error,omitempty"`
+	LastErrorAt   string `json:"last_error_at,omitempty" yaml:"last_error_at,omitempty"`
+	Pending       uint64 `json:"pending" yaml:"pending"`
+	Conflicts     uint64 `json:"conflicts" yaml:"conflicts"`
+}
+
+func init() {
+	syncCmd.AddCommand(statusCmd)
+}
@@ -0,0 +1,40 @@
+package sync
+
+import (
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/root"
+)
+
+// syncCmd represents the sync management command
+var syncCmd = &cobra.Command{
+	Use:   "sync",
+	Short: "Manage gpwd agent sync with the server",
+	Long:  `This is a cli for inspecting and triggering the agent sync`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Sync subcommands share these keys with other commands, bind them to the sync flags
+		cobra.CheckErr(viper.BindPFlag("socket_path", cmd.Flags().Lookup("socketPath")))
+		cobra.CheckErr(viper.BindPFlag("cert_path", cmd.Flags().Lookup("certPath")))
+		cobra.CheckErr(viper.BindPFlag("timeout", cmd.Flags().Lookup("timeout")))
+	},
+}
+
+func init() {
+	home, err := os.UserHomeDir()
+	cobra.CheckErr(err)
+
+	root.AddCommand(syncCmd)
+
+	// A socket file for agent connection
+	syncCmd.PersistentFlags().String("socketPath", home+"/.gpwd/gpwd.sock", "Agent socket path")
+
+	// An agent response timeout
+	syncCmd.PersistentFlags().Duration("timeout", 10*time.Second, "Agent timeout")
+
+	// An agent certificate for auth
+	syncCmd.PersistentFlags().String("certPath", home+"/.gpwd/agent.pem", "Agent TLS certificate PEM file")
+}
@@ -0,0 +1,168 @@
+package transfer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/cmd/root"
+	"github.com/go-rfe/gpwd/internal/archive"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var ErrPlaintextNotConfirmed = errors.New("plaintext export writes decrypted secrets to disk, confirm it with --plaintext")
+
+// exportCmd represents the command for exporting the vault
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "export all secrets using gpwd agent",
+	Long: `cli exports all secrets including deleted ones with labels, timestamps and history.
+The gpwdx archive is encrypted with a passphrase and restored with gpwd import --from <archive>.
+The passphrase is asked for or taken from ARCHIVE_PASSPHRASE environment variable.
+JSON and CSV exports are not encrypted and require --plaintext confirmation`,
+	PreRun: cli.BindAgentFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		format := viper.GetString("export_format")
+		switch format {
+		case archive.FormatArchive:
+		case archive.FormatJSON, archive.FormatCSV:
+			if !viper.GetBool("export_plaintext") {
+				cobra.CheckErr(ErrPlaintextNotConfirmed)
+			}
+		default:
+			cobra.CheckErr(fmt.Errorf("unknown export format %q", format))
+		}
+
+		var passphrase []byte
+		if format == archive.FormatArchive {
+			passphrase, err = askForNewPassphrase()
+			cobra.CheckErr(err)
+		}
+
+		var exported []*pb.ExportedSecret
+		cobra.CheckErr(cli.WithUnlock(func() error {
+			ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+			defer cancel()
+
+			client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+			if err != nil {
+				return err
+			}
+
+			exported, err = client.Export()
+			return err
+		}))
+
+		vault := &pb.Archive{
+			Secrets:   exported,
+			CreatedAt: timestamppb.Now(),
+		}
+
+		var buf bytes.Buffer
+		switch format {
+		case archive.FormatArchive:
+			kdf, err := encryption.NewKDFParams(0, 0, 0)
+			cobra.CheckErr(err)
+
+			cobra.CheckErr(archive.Write(&buf, vault, passphrase, kdf))
+		case archive.FormatJSON:
+			cobra.CheckErr(archive.WriteJSON(&buf, vault))
+		case archive.FormatCSV:
+			cobra.CheckErr(archive.WriteCSV(&buf, vault))
+		}
+
+		cobra.CheckErr(writeFile(viper.GetString("export_out"), buf.Bytes()))
+
+		view := exportResult{Out: viper.GetString("export_out"), Format: format, Secrets: len(exported)}
+		cobra.CheckErr(printer.Print(os.Stdout, view, func(w io.Writer) error {
+			_, err := fmt.Fprintf(w, "Exported %d secrets to %s\n", view.Secrets, view.Out)
+			return err
+		}))
+	},
+}
+
+// exportResult is the result of the export
+type exportResult struct {
+	Out     string `json:"out" yaml:"out"`
+	Format  string `json:"format" yaml:"format"`
+	Secrets int    `json:"secrets" yaml:"secrets"`
+}
+
+// askForNewPassphrase asks for the archive passphrase twice unless it's set in the environment
+func askForNewPassphrase() ([]byte, error) {
+	if passphrase := viper.GetString("archive_passphrase"); passphrase != "" {
+		return []byte(passphrase), nil
+	}
+
+	passphrase, err := encryption.AskForSecretInput("Please type the archive passphrase:")
+	if err != nil {
+		return nil, err
+	}
+
+	confirmation, err := encryption.AskForSecretInput("Please retype the archive passphrase:")
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case len(passphrase) == 0:
+		return nil, archive.ErrEmptyPassphrase
+	case !bytes.Equal(passphrase, confirmation):
+		return nil, errors.New("archive passphrases don't match")
+	}
+
+	return passphrase, nil
+}
+
+// writeFile writes the export readable by the owner only, an existing file is replaced
+func writeFile(path string, data []byte) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	if err := file.Chmod(0600); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return err
+	}
+
+	return file.Close()
+}
+
+func init() {
+	root.AddCommand(exportCmd)
+	cli.AddAgentFlags(exportCmd, time.Minute)
+
+	exportCmd.Flags().String("out", "", "Export file path")
+	cobra.CheckErr(viper.BindPFlag("export_out", exportCmd.Flags().Lookup("out")))
+	cobra.CheckErr(exportCmd.MarkFlagRequired("out"))
+
+	exportCmd.Flags().String("format", archive.FormatArchive,
+		fmt.Sprintf("Export format: %s|%s|%s", archive.FormatArchive, archive.FormatJSON, archive.FormatCSV))
+	cobra.CheckErr(viper.BindPFlag("export_format", exportCmd.Flags().Lookup("format")))
+
+	exportCmd.Flags().Bool("plaintext", false, "Confirm writing decrypted secrets to disk for json and csv formats")
+	cobra.CheckErr(viper.BindPFlag("export_plaintext", exportCmd.Flags().Lookup("plaintext")))
+
+	cobra.CheckErr(viper.BindEnv("archive_passphrase", "ARCHIVE_PASSPHRASE"))
+}
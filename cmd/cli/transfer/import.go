@@ -0,0 +1,158 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/cmd/root"
+	"github.com/go-rfe/gpwd/internal/archive"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/importer"
+	"github.com/go-rfe/gpwd/internal/output"
+)
+
+// importCmd represents the command for importing exports of other password managers
+var importCmd = &cobra.Command{
+	Use:   "import",
+	Short: "import secrets from other password managers using gpwd agent",
+	Long: fmt.Sprintf(`cli reads the export of other password manager and creates secrets in bulk.
+Supported formats: %s. The pass format expects a decrypted password store tree.
+Entry titles and folders become name and folder labels, every tag becomes the tag.<name>=true label.
+Entries which can't be imported are reported as skipped.
+The %s archive made by gpwd export is restored with IDs, timestamps and history of secrets`,
+		strings.Join(importer.Formats(), ", "), archive.FormatArchive),
+	PreRun: cli.BindAgentFlags,
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		from := viper.GetString("import_from")
+		format := viper.GetString("import_format")
+		if format == archive.FormatArchive || (format == "" && filepath.Ext(from) == archive.Extension) {
+			restoreArchive(printer, from)
+			return
+		}
+
+		result, err := importer.Parse(format, from)
+		cobra.CheckErr(err)
+
+		if viper.GetBool("import_dry_run") {
+			cobra.CheckErr(printImported(printer, nil, result))
+			return
+		}
+
+		var ids []string
+		if len(result.Secrets) > 0 {
+			cobra.CheckErr(cli.WithUnlock(func() error {
+				ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+				defer cancel()
+
+				client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+				if err != nil {
+					return err
+				}
+
+				ids, err = client.CreateSecrets(result.Secrets, viper.GetStringSlice("import_labels"))
+				return err
+			}))
+		}
+
+		cobra.CheckErr(printImported(printer, ids, result))
+	},
+}
+
+// imported is the result of the import, IDs of secrets are empty on dry-run
+type imported struct {
+	Secrets []output.Secret `json:"secrets" yaml:"secrets"`
+	Skipped []skipped       `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+}
+
+// skipped is the entry which isn't imported or restored
+type skipped struct {
+	Name   string `json:"name" yaml:"name"`
+	Reason string `json:"reason" yaml:"reason"`
+}
+
+// printImported prints imported secrets and skipped entries, the ID column is omitted on dry-run
+func printImported(printer *output.Printer, ids []string, result *importer.Result) error {
+	view := imported{Secrets: output.NewSecrets(result.Secrets)}
+	for i := range ids {
+		view.Secrets[i].ID = ids[i]
+	}
+	for _, entry := range result.Skipped {
+		view.Skipped = append(view.Skipped, skipped{Name: entry.Name, Reason: entry.Reason})
+	}
+
+	return printer.Print(os.Stdout, view, func(w io.Writer) error {
+		header := []string{"Type", "Labels"}
+		if ids != nil {
+			header = append([]string{"ID"}, header...)
+		}
+
+		rows := make([][]string, 0, len(view.Secrets))
+		for _, secret := range view.Secrets {
+			labels, err := json.Marshal(secret.Labels)
+			if err != nil {
+				return err
+			}
+
+			row := []string{secret.Type, string(labels)}
+			if ids != nil {
+				row = append([]string{secret.ID}, row...)
+			}
+			rows = append(rows, row)
+		}
+
+		if err := output.WriteTable(w, header, rows); err != nil {
+			return err
+		}
+
+		return printSkipped(w, view.Skipped)
+	})
+}
+
+func printSkipped(w io.Writer, entries []skipped) error {
+	if len(entries) == 0 {
+		return nil
+	}
+
+	rows := make([][]string, 0, len(entries))
+	for _, entry := range entries {
+		rows = append(rows, []string{entry.Name, entry.Reason})
+	}
+
+	if _, err := fmt.Fprintln(w); err != nil {
+		return err
+	}
+
+	return output.WriteTable(w, []string{"Skipped", "Reason"}, rows)
+}
+
+func init() {
+	root.AddCommand(importCmd)
+	cli.AddAgentFlags(importCmd, time.Minute)
+
+	importCmd.Flags().String("format", "",
+		"Export format: "+strings.Join(append(importer.Formats(), archive.FormatArchive), "|")+", gpwdx archives are detected by extension")
+	cobra.CheckErr(viper.BindPFlag("import_format", importCmd.Flags().Lookup("format")))
+
+	importCmd.Flags().String("from", "", "Export file path or password store directory for the pass format")
+	cobra.CheckErr(viper.BindPFlag("import_from", importCmd.Flags().Lookup("from")))
+	cobra.CheckErr(importCmd.MarkFlagRequired("from"))
+
+	importCmd.Flags().Bool("dryRun", false, "Report what would be imported without creating secrets")
+	cobra.CheckErr(viper.BindPFlag("import_dry_run", importCmd.Flags().Lookup("dryRun")))
+
+	importCmd.Flags().StringSlice("labels", nil, "Labels key=value, pairs added to every imported secret, archives are restored as is")
+	cobra.CheckErr(viper.BindPFlag("import_labels", importCmd.Flags().Lookup("labels")))
+}
@@ -0,0 +1,111 @@
+package transfer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/cmd/cli"
+	"github.com/go-rfe/gpwd/internal/archive"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/output"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// restoreArchive restores secrets of the encrypted archive keeping their IDs,
+// secrets already existing in the vault are reported as skipped
+func restoreArchive(printer *output.Printer, path string) {
+	file, err := os.Open(path)
+	cobra.CheckErr(err)
+	defer file.Close()
+
+	passphrase := []byte(viper.GetString("archive_passphrase"))
+	if len(passphrase) == 0 {
+		passphrase, err = encryption.AskForSecretInput("Please type the archive passphrase:")
+		cobra.CheckErr(err)
+	}
+
+	vault, err := archive.Read(file, passphrase)
+	cobra.CheckErr(err)
+
+	if viper.GetBool("import_dry_run") {
+		cobra.CheckErr(printArchive(printer, vault.GetSecrets()))
+		return
+	}
+
+	var ids, skippedIDs []string
+	cobra.CheckErr(cli.WithUnlock(func() error {
+		ctx, cancel := context.WithTimeout(context.Background(), viper.GetDuration("timeout"))
+		defer cancel()
+
+		client, err := secrets.NewSecretsClient(ctx, viper.GetString("socket_path"))
+		if err != nil {
+			return err
+		}
+
+		ids, skippedIDs, err = client.RestoreSecrets(vault.GetSecrets())
+		return err
+	}))
+
+	view := restored{Restored: ids}
+	for _, id := range skippedIDs {
+		view.Skipped = append(view.Skipped, skipped{Name: id, Reason: "secret already exists"})
+	}
+
+	cobra.CheckErr(printer.Print(os.Stdout, view, func(w io.Writer) error {
+		if _, err := fmt.Fprintf(w, "Restored %d secrets\n", len(view.Restored)); err != nil {
+			return err
+		}
+
+		return printSkipped(w, view.Skipped)
+	}))
+}
+
+// restored is the result of the archive restore
+type restored struct {
+	Restored []string  `json:"restored" yaml:"restored"`
+	Skipped  []skipped `json:"skipped,omitempty" yaml:"skipped,omitempty"`
+}
+
+// archived is the secret of the archive with the number of its prior versions
+type archived struct {
+	output.Secret `yaml:",inline"`
+	Versions      int `json:"versions" yaml:"versions"`
+}
+
+// printArchive prints secrets of the archive with the number of their prior versions
+func printArchive(printer *output.Printer, exported []*pb.ExportedSecret) error {
+	views := make([]archived, 0, len(exported))
+	for _, entry := range exported {
+		views = append(views, archived{Secret: output.NewSecret(entry.GetSecret()), Versions: len(entry.GetVersions())})
+	}
+
+	return printer.Print(os.Stdout, views, func(w io.Writer) error {
+		rows := make([][]string, 0, len(views))
+		for _, view := range views {
+			var labels []byte
+			if len(view.Labels) > 0 {
+				var err error
+				labels, err = json.Marshal(view.Labels)
+				if err != nil {
+					return err
+				}
+			}
+
+			rows = append(rows, []string{view.ID,
+				view.Type,
+				string(labels),
+				strconv.FormatBool(view.Deleted),
+				strconv.Itoa(view.Versions)})
+		}
+
+		return output.WriteTable(w, []string{"ID", "Type", "Labels", "Deleted", "Versions"}, rows)
+	})
+}
@@ -0,0 +1,2 @@
+// Package transfer implements commands moving secrets in and out of the agent
+package transfer
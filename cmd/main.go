@@ -6,7 +6,11 @@ import (
 	// Register commands
 	_ "github.com/go-rfe/gpwd/cmd/agent"
 	_ "github.com/go-rfe/gpwd/cmd/cli/account"
+	_ "github.com/go-rfe/gpwd/cmd/cli/generate"
+	_ "github.com/go-rfe/gpwd/cmd/cli/inject"
 	_ "github.com/go-rfe/gpwd/cmd/cli/secret"
+	_ "github.com/go-rfe/gpwd/cmd/cli/sync"
+	_ "github.com/go-rfe/gpwd/cmd/cli/transfer"
 	_ "github.com/go-rfe/gpwd/cmd/server"
 )
 
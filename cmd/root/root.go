@@ -4,8 +4,10 @@ import (
 	"os"
 
 	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
 
 	"github.com/go-rfe/gpwd/internal/logging"
+	"github.com/go-rfe/gpwd/internal/output"
 )
 
 var (
@@ -37,6 +39,11 @@ func init() {
 
 	// Global log level
 	rootCmd.PersistentFlags().StringVar(&logLevel, "logLevel", "ERROR", "log level (DEBUG|INFO|WARNING|ERROR)")
+
+	// Global output format of command results
+	rootCmd.PersistentFlags().StringP("output", "o", output.FormatTable,
+		"output format (table|json|yaml|env|template=<Go template>), e.g. -o 'template={{.ID}}'")
+	cobra.CheckErr(viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output")))
 }
 
 func setLogLevel() {
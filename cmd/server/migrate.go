@@ -0,0 +1,93 @@
+package server
+
+import (
+	"io"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/go-rfe/gpwd/internal/output"
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
+)
+
+// migrateCmd represents the command for managing the server database schema
+var migrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "manage gpwd server database schema",
+	Long: `Server applies pending schema migrations on start,
+migrate applies or rolls back migrations of the database without starting the server`,
+}
+
+var migrateUpCmd = &cobra.Command{
+	Use:   "up",
+	Short: "apply all pending schema migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		migration := openMigration()
+		defer closeMigration(migration)
+
+		cobra.CheckErr(migration.Up())
+	},
+}
+
+var migrateDownCmd = &cobra.Command{
+	Use:   "down",
+	Short: "roll back the latest applied schema migrations",
+	Run: func(cmd *cobra.Command, args []string) {
+		migration := openMigration()
+		defer closeMigration(migration)
+
+		cobra.CheckErr(migration.Down(viper.GetInt("migrate_down_steps")))
+	},
+}
+
+var migrateStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "show the database schema version",
+	Run: func(cmd *cobra.Command, args []string) {
+		printer, err := output.New(viper.GetString("output"))
+		cobra.CheckErr(err)
+
+		migration := openMigration()
+		defer closeMigration(migration)
+
+		status, err := migration.Status()
+		cobra.CheckErr(err)
+
+		view := schema{Version: status.Version, Latest: status.Latest, Dirty: status.Dirty}
+		cobra.CheckErr(printer.Print(os.Stdout, view, func(w io.Writer) error {
+			return output.WriteTable(w, nil, [][]string{
+				{"Version", strconv.FormatUint(uint64(view.Version), 10)},
+				{"Latest", strconv.FormatUint(uint64(view.Latest), 10)},
+				{"Dirty", strconv.FormatBool(view.Dirty)},
+			})
+		}))
+	},
+}
+
+// schema is the database schema version
+type schema struct {
+	Version uint `json:"version" yaml:"version"`
+	Latest  uint `json:"latest" yaml:"latest"`
+	Dirty   bool `json:"dirty" yaml:"dirty"`
+}
+
+func openMigration() *cloud.Migration {
+	migration, err := cloud.OpenMigration(viper.GetString("database_dsn"))
+	cobra.CheckErr(err)
+
+	return migration
+}
+
+func closeMigration(migration *cloud.Migration) {
+	cobra.CheckErr(migration.Close())
+}
+
+func init() {
+	serverCmd.AddCommand(migrateCmd)
+	migrateCmd.AddCommand(migrateUpCmd, migrateDownCmd, migrateStatusCmd)
+
+	migrateDownCmd.Flags().Int("steps", 1, "Number of migrations to roll back")
+	cobra.CheckErr(viper.BindPFlag("migrate_down_steps", migrateDownCmd.Flags().Lookup("steps")))
+}
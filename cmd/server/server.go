@@ -1,7 +1,9 @@
 package server
 
 import (
+	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -10,6 +12,9 @@ import (
 	"github.com/go-rfe/gpwd/cmd/root"
 	"github.com/go-rfe/gpwd/internal/logging/log"
 	"github.com/go-rfe/gpwd/internal/server"
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
+	// Register the embedded SQLite storage backend
+	_ "github.com/go-rfe/gpwd/internal/storage/cloud/sqlite"
 )
 
 var serverCmd = &cobra.Command{
@@ -32,7 +37,9 @@ var (
 )
 
 const (
-	defaultTokenLifeSpan = 3600 * time.Second
+	defaultTokenLifeSpan     = 3600 * time.Second
+	defaultVersionsRetention = 10
+	defaultTrashRetention    = 30 * 24 * time.Hour
 )
 
 func init() {
@@ -52,13 +59,19 @@ func init() {
 	serverCmd.Flags().String("keyPath", home+"/.gpwd/server-key.pem", "Server TLS key PEM file")
 	cobra.CheckErr(viper.BindPFlag("server_key_path", serverCmd.Flags().Lookup("keyPath")))
 
-	serverCmd.Flags().String("databaseDSN", "", "Server database DSN")
-	cobra.CheckErr(viper.BindPFlag("database_dsn", serverCmd.Flags().Lookup("databaseDSN")))
+	serverCmd.PersistentFlags().String("databaseDSN", "", fmt.Sprintf("Server database DSN, the store is selected by the DSN scheme (%s)", strings.Join(cloud.Schemes(), ", ")))
+	cobra.CheckErr(viper.BindPFlag("database_dsn", serverCmd.PersistentFlags().Lookup("databaseDSN")))
 
 	serverCmd.Flags().Duration("tokenLifespan", defaultTokenLifeSpan, "Server token lifespan")
 	cobra.CheckErr(viper.BindPFlag("token_lifespan", serverCmd.Flags().Lookup("tokenLifespan")))
 
-	serverCmd.Flags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.gpwd.yaml)")
+	serverCmd.Flags().Int("versionsRetention", defaultVersionsRetention, "Number of prior secret versions to keep, 0 keeps all of them")
+	cobra.CheckErr(viper.BindPFlag("server_versions_retention", serverCmd.Flags().Lookup("versionsRetention")))
+
+	serverCmd.Flags().Duration("trashRetention", defaultTrashRetention, "Purge deleted secrets after this period, 0 keeps them forever")
+	cobra.CheckErr(viper.BindPFlag("server_trash_retention", serverCmd.Flags().Lookup("trashRetention")))
+
+	serverCmd.PersistentFlags().StringVarP(&cfgFile, "config", "c", "", "config file (default is $HOME/.gpwd.yaml)")
 }
 
 // InitConfig reads in config file and ENV variables if set.
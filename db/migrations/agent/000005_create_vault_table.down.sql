@@ -0,0 +1 @@
+DROP TABLE IF EXISTS vault;
\ No newline at end of file
@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS vault (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    kdf TEXT NOT NULL,
+    salt BLOB NOT NULL,
+    time INTEGER NOT NULL,
+    memory INTEGER NOT NULL,
+    threads INTEGER NOT NULL,
+    verifier BLOB NOT NULL
+);
\ No newline at end of file
@@ -0,0 +1,5 @@
+ALTER TABLE vault
+DROP COLUMN wrapped_key;
+
+ALTER TABLE vault
+DROP COLUMN recovery_key;
@@ -0,0 +1,5 @@
+ALTER TABLE vault
+ADD COLUMN wrapped_key BLOB DEFAULT NULL;
+
+ALTER TABLE vault
+ADD COLUMN recovery_key BLOB DEFAULT NULL;
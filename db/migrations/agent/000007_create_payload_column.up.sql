@@ -0,0 +1,2 @@
+ALTER TABLE secrets
+ADD COLUMN payload BLOB DEFAULT NULL;
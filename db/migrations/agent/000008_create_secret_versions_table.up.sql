@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS secret_versions (
+    secret_id TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    labels TEXT,
+    created_at TEXT,
+    updated_at TEXT,
+    data BLOB,
+    payload BLOB,
+    archived_at TEXT NOT NULL,
+    PRIMARY KEY (secret_id, version)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS secret_conflicts;
+
+ALTER TABLE secrets
+DROP COLUMN revision;
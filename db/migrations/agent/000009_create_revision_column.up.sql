@@ -0,0 +1,19 @@
+ALTER TABLE secrets
+ADD COLUMN revision INTEGER DEFAULT 0 NOT NULL;
+
+-- Secrets synced before revisions were introduced exist on the server with the first revision,
+-- so do the pending updates and deletions of them
+UPDATE secrets SET revision=1 WHERE synced=true OR deleted=true OR (updated_at IS NOT NULL AND updated_at != '');
+
+CREATE TABLE IF NOT EXISTS secret_conflicts (
+    secret_id TEXT PRIMARY KEY,
+    labels TEXT,
+    created_at TEXT,
+    updated_at TEXT,
+    deleted_at TEXT,
+    deleted BOOLEAN DEFAULT false NOT NULL,
+    data BLOB,
+    payload BLOB,
+    revision INTEGER NOT NULL,
+    detected_at TEXT NOT NULL
+);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS secrets_synced_idx;
+
+ALTER TABLE accounts
+DROP COLUMN sync_cursor;
@@ -0,0 +1,4 @@
+ALTER TABLE accounts
+ADD COLUMN sync_cursor INTEGER DEFAULT 0 NOT NULL;
+
+CREATE INDEX IF NOT EXISTS secrets_synced_idx ON secrets (synced);
@@ -0,0 +1,14 @@
+// Package migrations embeds server schema migrations, agent migrations are embedded with go-bindata
+package migrations
+
+import "embed"
+
+// Server holds migrations of the server PostgreSQL store
+//
+//go:embed server/*.sql
+var Server embed.FS
+
+// ServerSQLite holds migrations of the server SQLite store
+//
+//go:embed server-sqlite/*.sql
+var ServerSQLite embed.FS
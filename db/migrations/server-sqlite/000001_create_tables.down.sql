@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS secrets_change_seq;
+DROP TABLE IF EXISTS secret_versions;
+DROP INDEX IF EXISTS secrets_username_change_seq_idx;
+DROP TABLE IF EXISTS secrets;
+DROP TABLE IF EXISTS accounts;
@@ -0,0 +1,42 @@
+CREATE TABLE IF NOT EXISTS accounts (
+    id INTEGER PRIMARY KEY AUTOINCREMENT,
+    username TEXT UNIQUE,
+    password BLOB
+);
+
+CREATE TABLE IF NOT EXISTS secrets (
+    id TEXT PRIMARY KEY,
+    username TEXT REFERENCES accounts(username),
+    labels TEXT,
+    created_at TEXT,
+    updated_at TEXT,
+    deleted_at TEXT,
+    deleted BOOLEAN DEFAULT false NOT NULL,
+    data BLOB,
+    payload BLOB,
+    revision INTEGER DEFAULT 1 NOT NULL,
+    change_seq INTEGER NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS secrets_username_change_seq_idx ON secrets (username, change_seq);
+
+CREATE TABLE IF NOT EXISTS secret_versions (
+    secret_id TEXT NOT NULL,
+    username TEXT REFERENCES accounts(username),
+    version INTEGER NOT NULL,
+    labels TEXT,
+    created_at TEXT,
+    updated_at TEXT,
+    data BLOB,
+    payload BLOB,
+    archived_at TEXT NOT NULL,
+    PRIMARY KEY (secret_id, version)
+);
+
+-- SQLite has no sequences, the change sequence is kept in a single row
+CREATE TABLE IF NOT EXISTS secrets_change_seq (
+    id INTEGER PRIMARY KEY CHECK (id = 1),
+    value INTEGER NOT NULL
+);
+
+INSERT INTO secrets_change_seq (id, value) VALUES (1, 0);
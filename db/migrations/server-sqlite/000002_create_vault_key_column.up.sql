@@ -0,0 +1 @@
+ALTER TABLE accounts ADD COLUMN vault_key BLOB;
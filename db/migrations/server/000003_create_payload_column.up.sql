@@ -0,0 +1,2 @@
+ALTER TABLE secrets
+ADD COLUMN payload bytea;
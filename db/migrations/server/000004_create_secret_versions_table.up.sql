@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS secret_versions (
+    secret_id VARCHAR NOT NULL,
+    username VARCHAR REFERENCES accounts(username),
+    version INTEGER NOT NULL,
+    labels VARCHAR,
+    created_at TIMESTAMP,
+    updated_at TIMESTAMP,
+    data bytea,
+    payload bytea,
+    archived_at TIMESTAMP NOT NULL,
+    PRIMARY KEY (secret_id, version)
+);
@@ -0,0 +1,2 @@
+ALTER TABLE secrets
+ADD COLUMN revision BIGINT DEFAULT 1 NOT NULL;
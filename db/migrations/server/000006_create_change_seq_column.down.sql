@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS secrets_username_change_seq_idx;
+
+ALTER TABLE secrets
+DROP COLUMN change_seq;
+
+DROP SEQUENCE IF EXISTS secrets_change_seq;
@@ -0,0 +1,6 @@
+CREATE SEQUENCE IF NOT EXISTS secrets_change_seq;
+
+ALTER TABLE secrets
+ADD COLUMN change_seq BIGINT DEFAULT nextval('secrets_change_seq') NOT NULL;
+
+CREATE INDEX IF NOT EXISTS secrets_username_change_seq_idx ON secrets (username, change_seq);
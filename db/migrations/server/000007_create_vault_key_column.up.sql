@@ -0,0 +1,2 @@
+ALTER TABLE accounts
+ADD COLUMN vault_key bytea;
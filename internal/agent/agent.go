@@ -2,7 +2,7 @@ package agent
 
 import (
 	"context"
-	"io"
+	"fmt"
 	"net"
 	"os"
 	"os/signal"
@@ -15,35 +15,64 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
-	"github.com/go-rfe/gpwd/internal/encryption"
 	"github.com/go-rfe/gpwd/internal/logging/log"
 	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
 	"github.com/go-rfe/gpwd/internal/storage/local"
 )
 
+// maxRecvMessageSize lets the vault be restored in a single request
+const maxRecvMessageSize = 256 << 20
+
 type Cfg struct {
-	SocketPath     string        `mapstructure:"socket_path"`
-	SyncInterval   time.Duration `mapstructure:"sync_interval"`
-	StorePath      string        `mapstructure:"store_path"`
-	CertPath       string        `mapstructure:"agent_cert_path"`
-	KeyPath        string        `mapstructure:"agent_key_path"`
-	MasterPassword []byte        `mapstructure:"master_password"`
+	SocketPath        string        `mapstructure:"socket_path"`
+	SyncInterval      time.Duration `mapstructure:"sync_interval"`
+	StorePath         string        `mapstructure:"store_path"`
+	StoreBackend      string        `mapstructure:"store_backend"`
+	CertPath          string        `mapstructure:"agent_cert_path"`
+	KeyPath           string        `mapstructure:"agent_key_path"`
+	MasterPassword    []byte        `mapstructure:"master_password"`
+	KDFTime           uint32        `mapstructure:"kdf_time"`
+	KDFMemory         uint32        `mapstructure:"kdf_memory"`
+	KDFThreads        uint8         `mapstructure:"kdf_threads"`
+	LockTimeout       time.Duration `mapstructure:"lock_timeout"`
+	AllowedUIDs       []uint32      `mapstructure:"allowed_uids"`
+	VersionsRetention int           `mapstructure:"agent_versions_retention"`
+	TrashRetention    time.Duration `mapstructure:"agent_trash_retention"`
+
+	// ServerDialer replaces the network dialer of server connections, e.g. with in-memory connections in tests
+	ServerDialer func(ctx context.Context, address string) (net.Conn, error) `mapstructure:"-"`
 }
 
 type agent struct {
-	cfg             *Cfg
-	secretsStorage  local.Secrets
-	accountsStorage local.Accounts
-	mu              sync.RWMutex
-	encrypt         func([]byte) ([]byte, error)
-	decrypt         func([]byte) ([]byte, error)
+	lastActivity     int64 // unix nanoseconds, accessed atomically
+	watching         int32 // 1 while the server pushes changes, accessed atomically
+	cfg              *Cfg
+	secretsStorage   local.Secrets
+	accountsStorage  local.Accounts
+	vaultStorage     local.Vault
+	versionsStorage  local.SecretVersions
+	conflictsStorage local.SecretConflicts
+	mu               sync.RWMutex
+	dataKey          []byte
+	encrypt          func([]byte) ([]byte, error)
+	decrypt          func([]byte) ([]byte, error)
+	syncPassword     []byte
+	otpMu            sync.Mutex
+	syncRequests     chan struct{}
+	syncMu           sync.Mutex
+	statusMu         sync.RWMutex
+	lastSyncAt       time.Time
+	lastSyncErr      error
+	lastSyncErrAt    time.Time
 	pb.UnimplementedSecretsServer
 	pb.UnimplementedAccountsServer
+	pb.UnimplementedAgentServer
 }
 
 func NewAgent(cfg *Cfg) *agent {
 	return &agent{
-		cfg: cfg,
+		cfg:          cfg,
+		syncRequests: make(chan struct{}, 1),
 	}
 }
 
@@ -59,49 +88,59 @@ func (a *agent) Run() {
 		log.Fatal().Err(err).Msg("couldn't create agent working directory")
 	}
 
-	storage, err := local.NewSQLiteStorage(a.cfg.StorePath, a.cfg.MasterPassword)
+	listener, err := a.createListener()
 	if err != nil {
-		log.Fatal().Err(err).Msgf("failed to create storage")
+		log.Fatal().Err(err).Msgf("failed to create listener")
 	}
-	defer func(closer io.Closer) {
-		if err := closer.Close(); err != nil {
-			log.Error().Err(err).Msgf("failed to close storage")
-		}
-	}(storage)
 
-	a.secretsStorage = storage
-	a.accountsStorage = storage
+	if err := a.Serve(ctx, listener); err != nil {
+		_ = listener.Close()
+		log.Fatal().Err(err).Msg("couldn't start agent")
+	}
+}
 
-	encrypt, decrypt, err := encryption.GetCrypto(a.cfg.MasterPassword)
+// Serve opens the vault and serves RPCs on the listener until ctx is done
+func (a *agent) Serve(ctx context.Context, listener net.Listener) error {
+	storage, err := a.openVault(ctx)
 	if err != nil {
-		log.Fatal().Err(err).Msgf("failed to init encryption")
+		return fmt.Errorf("failed to open vault: %w", err)
 	}
+	defer closeStorage(storage)
 
-	a.encrypt = encrypt
-	a.decrypt = decrypt
+	a.setStorage(storage)
 
-	listener, err := a.createListener()
-	if err != nil {
-		log.Fatal().Err(err).Msgf("failed to create listener")
-	}
+	// The master password is needed again only to unlock the agent
+	wipe(a.cfg.MasterPassword)
+	a.cfg.MasterPassword = nil
+	a.touch()
 
-	wg := sync.WaitGroup{}
+	workersCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		if err := a.listenEndServe(ctx, listener); err != nil {
-			log.Fatal().Err(err).Msg("couldn't start agent")
-		}
-	}()
+	wg := sync.WaitGroup{}
+	for _, worker := range []func(context.Context){a.syncWorker, a.watchWorker, a.lockWorker, a.purgeWorker} {
+		wg.Add(1)
+		go func(worker func(context.Context)) {
+			defer wg.Done()
+			worker(workersCtx)
+		}(worker)
+	}
 
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		a.syncWorker(ctx)
-	}()
+	err = a.listenEndServe(ctx, listener)
 
+	cancel()
 	wg.Wait()
+
+	return err
+}
+
+// setStorage makes the opened store available to RPCs and workers
+func (a *agent) setStorage(storage local.Storage) {
+	a.secretsStorage = storage
+	a.accountsStorage = storage
+	a.vaultStorage = storage
+	a.versionsStorage = storage
+	a.conflictsStorage = storage
 }
 
 func (a *agent) createDirs() error {
@@ -124,11 +163,19 @@ func (a *agent) createDirs() error {
 }
 
 func (a *agent) createListener() (net.Listener, error) {
+	// Restrict the socket file from the moment it is created
+	mask := syscall.Umask(0177)
 	l, err := net.Listen("unix", a.cfg.SocketPath)
+	syscall.Umask(mask)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := os.Chmod(a.cfg.SocketPath, socketMode); err != nil {
+		_ = l.Close()
+		return nil, err
+	}
+
 	return l, err
 }
 
@@ -138,10 +185,15 @@ func (a *agent) listenEndServe(ctx context.Context, listener net.Listener) error
 		return err
 	}
 
-	grpcServer := grpc.NewServer(grpc.Creds(serverTransportCreds))
+	grpcServer := grpc.NewServer(
+		grpc.Creds(newPeerCredentials(serverTransportCreds, a.cfg.AllowedUIDs)),
+		grpc.ChainUnaryInterceptor(a.callerUnaryInterceptor, a.activityUnaryInterceptor, a.syncUnaryInterceptor),
+		grpc.MaxRecvMsgSize(maxRecvMessageSize),
+	)
 
 	pb.RegisterSecretsServer(grpcServer, a)
 	pb.RegisterAccountsServer(grpcServer, a)
+	pb.RegisterAgentServer(grpcServer, a)
 
 	go func() {
 		<-ctx.Done()
@@ -0,0 +1,718 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+const testMasterPassword = "master password"
+
+// newTestAgent returns the unlocked agent with the in-memory vault, no RPC server or workers are started
+func newTestAgent(t *testing.T) *agent {
+	t.Helper()
+
+	a := NewAgent(&Cfg{
+		StorePath:      t.TempDir(),
+		StoreBackend:   local.MemoryBackend,
+		MasterPassword: []byte(testMasterPassword),
+		KDFTime:        1,
+		KDFMemory:      1024,
+		KDFThreads:     1,
+	})
+
+	storage, err := a.openVault(context.Background())
+	if err != nil {
+		t.Fatalf("openVault() error = %v", err)
+	}
+	t.Cleanup(func() {
+		closeStorage(storage)
+	})
+
+	a.setStorage(storage)
+
+	return a
+}
+
+func createSecret(t *testing.T, a *agent, secret *pb.Secret) string {
+	t.Helper()
+
+	resp, err := a.CreateSecret(context.Background(), &pb.CreateSecretRequest{Secret: secret})
+	if err != nil {
+		t.Fatalf("CreateSecret() error = %v", err)
+	}
+
+	return resp.GetId()
+}
+
+func getSecret(t *testing.T, a *agent, id string) *pb.Secret {
+	t.Helper()
+
+	resp, err := a.GetSecret(context.Background(), &pb.GetSecretRequest{Id: id, Decrypt: true})
+	if err != nil {
+		t.Fatalf("GetSecret(%s) error = %v", id, err)
+	}
+
+	return resp.GetSecret()
+}
+
+func TestSecretRPCs(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := createSecret(t, a, &pb.Secret{
+		Labels: map[string]string{"env": "prod"},
+		Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+			Username: "alice",
+			Password: []byte("password"),
+		}},
+	})
+
+	stored, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(stored.GetLogin().GetPassword(), []byte("password")) {
+		t.Error("password is stored in plaintext")
+	}
+
+	secret := getSecret(t, a, id)
+	if got := string(secret.GetLogin().GetPassword()); got != "password" {
+		t.Errorf("GetSecret() password = %q, want %q", got, "password")
+	}
+	if got := secret.GetLogin().GetUsername(); got != "alice" {
+		t.Errorf("GetSecret() username = %q, want %q", got, "alice")
+	}
+
+	resp, err := a.ListSecrets(ctx, &pb.ListSecretsRequest{Selector: "env=prod"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.GetTotal() != 1 || resp.GetSecrets()[0].GetID() != id {
+		t.Errorf("ListSecrets(env=prod) = %v, want %s", resp.GetSecrets(), id)
+	}
+
+	if _, err := a.ListSecrets(ctx, &pb.ListSecretsRequest{Selector: "env in prod"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ListSecrets() of invalid selector error = %v, want %s", err, codes.InvalidArgument)
+	}
+}
+
+func TestUpdateTypedSecret(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := createSecret(t, a, &pb.Secret{
+		Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+			Username: "alice",
+			Password: []byte("password"),
+			TOTPSeed: []byte("seed"),
+		}},
+	})
+
+	// The data-only update replaces the password and keeps the rest of the login
+	_, err := a.UpdateSecret(ctx, &pb.UpdateSecretRequest{Secret: &pb.Secret{
+		ID:     id,
+		Data:   []byte("new password"),
+		Labels: map[string]string{"env": "dev"},
+	}})
+	if err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+
+	secret := getSecret(t, a, id)
+	login := secret.GetLogin()
+	if login == nil {
+		t.Fatalf("GetSecret() = %v, want the login", secret)
+	}
+	if got := string(login.GetPassword()); got != "new password" {
+		t.Errorf("GetSecret() password = %q, want %q", got, "new password")
+	}
+	if got := login.GetUsername(); got != "alice" {
+		t.Errorf("GetSecret() username = %q, want %q", got, "alice")
+	}
+	if len(secret.GetData()) != 0 {
+		t.Errorf("GetSecret() data = %q, want none", secret.GetData())
+	}
+	if secret.GetLabels()["env"] != "dev" {
+		t.Errorf("GetSecret() labels = %v, want env=dev", secret.GetLabels())
+	}
+
+	stored, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seed, err := payload.OpenOTPSeed(stored, a.decrypt)
+	if err != nil {
+		t.Fatalf("OpenOTPSeed() error = %v", err)
+	}
+	if string(seed) != "seed" {
+		t.Errorf("OpenOTPSeed() = %q, want %q", seed, "seed")
+	}
+}
+
+func TestUpdateLabelsOnly(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := createSecret(t, a, &pb.Secret{Data: []byte("data"), Labels: map[string]string{"env": "dev"}})
+	loginID := createSecret(t, a, &pb.Secret{Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+		Username: "alice",
+		Password: []byte("password"),
+	}}})
+
+	for _, secret := range []*pb.Secret{
+		{ID: id, Data: []byte("data"), Labels: map[string]string{"env": "prod"}},
+		// The data-only update of the typed secret with the same password
+		{ID: loginID, Data: []byte("password"), Labels: map[string]string{"env": "prod"}},
+	} {
+		stored, err := a.secretsStorage.GetSecret(ctx, secret.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := a.UpdateSecret(ctx, &pb.UpdateSecretRequest{Secret: secret}); err != nil {
+			t.Fatalf("UpdateSecret() error = %v", err)
+		}
+
+		updated, err := a.secretsStorage.GetSecret(ctx, secret.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(updated.GetData(), stored.GetData()) ||
+			!bytes.Equal(updated.GetLogin().GetPassword(), stored.GetLogin().GetPassword()) {
+			t.Errorf("UpdateSecret() of labels resealed the content of %s", secret.GetID())
+		}
+		if updated.GetLabels()["env"] != "prod" {
+			t.Errorf("UpdateSecret() labels = %v, want env=prod", updated.GetLabels())
+		}
+
+		versions, err := a.versionsStorage.ListSecretVersions(ctx, secret.GetID())
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(versions) != 0 {
+			t.Errorf("ListSecretVersions() after label-only update = %v, want none", versions)
+		}
+	}
+
+	if _, err := a.UpdateSecret(ctx, &pb.UpdateSecretRequest{Secret: &pb.Secret{ID: id, Data: []byte("changed")}}); err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+
+	versions, err := a.versionsStorage.ListSecretVersions(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 1 {
+		t.Errorf("ListSecretVersions() after content update = %v, want one version", versions)
+	}
+}
+
+func TestFileTooLarge(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	file := func(size int) *pb.Secret {
+		return &pb.Secret{Payload: &pb.Secret_File{File: &pb.FilePayload{
+			Name:    "file.bin",
+			Content: make([]byte, size),
+		}}}
+	}
+
+	_, err := a.CreateSecret(ctx, &pb.CreateSecretRequest{Secret: file(payload.MaxFileSize + 1)})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateSecret() of large file error = %v, want %v", err, ErrFileTooLarge)
+	}
+
+	_, err = a.CreateSecrets(ctx, &pb.CreateSecretsRequest{Secrets: []*pb.Secret{file(1), file(payload.MaxFileSize + 1)}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("CreateSecrets() of large file error = %v, want %v", err, ErrFileTooLarge)
+	}
+
+	id := createSecret(t, a, file(payload.MaxFileSize))
+
+	// The data-only update replaces the content of the file
+	_, err = a.UpdateSecret(ctx, &pb.UpdateSecretRequest{Secret: &pb.Secret{ID: id, Data: make([]byte, payload.MaxFileSize+1)}})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("UpdateSecret() of large file error = %v, want %v", err, ErrFileTooLarge)
+	}
+
+	secrets, err := a.secretsStorage.ListSecrets(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(secrets) != 1 {
+		t.Errorf("ListSecrets() = %d secrets, want the file within the limit only", len(secrets))
+	}
+}
+
+func TestHOTPCounter(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	// RFC 4226 test secret
+	id := createSecret(t, a, &pb.Secret{
+		Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+			Username: "alice",
+			TOTPSeed: []byte("otpauth://hotp/gpwd:alice?secret=GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ&counter=0"),
+		}},
+	})
+
+	for _, want := range []string{"755224", "287082"} {
+		resp, err := a.GetSecretOTP(ctx, &pb.GetSecretOTPRequest{Id: id})
+		if err != nil {
+			t.Fatalf("GetSecretOTP() error = %v", err)
+		}
+		if resp.GetCode() != want {
+			t.Errorf("GetSecretOTP() code = %s, want %s", resp.GetCode(), want)
+		}
+	}
+
+	// Advancing the counter isn't a change of the user to keep in the history
+	versions, err := a.versionsStorage.ListSecretVersions(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) != 0 {
+		t.Errorf("ListSecretVersions() = %v, want none", versions)
+	}
+}
+
+func TestLockUnlock(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := createSecret(t, a, &pb.Secret{Data: []byte("data")})
+
+	if _, err := a.Lock(ctx, &pb.LockRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.GetSecret(ctx, &pb.GetSecretRequest{Id: id, Decrypt: true}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetSecret() of locked agent error = %v, want %s", err, codes.FailedPrecondition)
+	}
+
+	if _, err := a.Unlock(ctx, &pb.UnlockRequest{Password: []byte("wrong")}); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("Unlock() with wrong password error = %v, want %s", err, codes.Unauthenticated)
+	}
+
+	if _, err := a.Unlock(ctx, &pb.UnlockRequest{Password: []byte(testMasterPassword)}); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+
+	if got := string(getSecret(t, a, id).GetData()); got != "data" {
+		t.Errorf("GetSecret() data = %q, want %q", got, "data")
+	}
+}
+
+func TestIdleActivity(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	handler := func(context.Context, interface{}) (interface{}, error) {
+		return nil, nil
+	}
+
+	tests := []struct {
+		method  string
+		req     interface{}
+		refresh bool
+	}{
+		{"/proto.Secrets/ListSecrets", &pb.ListSecretsRequest{}, false},
+		{"/proto.Secrets/GetSecret", &pb.GetSecretRequest{}, false},
+		{"/proto.Secrets/GetSecret", &pb.GetSecretRequest{Decrypt: true}, true},
+		{"/proto.Secrets/CreateSecret", &pb.CreateSecretRequest{}, true},
+		{"/proto.Secrets/GetSecretOTP", &pb.GetSecretOTPRequest{}, true},
+		{"/proto.Secrets/ExportSecrets", &pb.ExportSecretsRequest{}, true},
+		{"/proto.Agent/SyncStatus", &pb.SyncStatusRequest{}, false},
+	}
+
+	for _, tt := range tests {
+		atomic.StoreInt64(&a.lastActivity, 0)
+
+		info := &grpc.UnaryServerInfo{FullMethod: tt.method}
+		if _, err := a.activityUnaryInterceptor(ctx, tt.req, info, handler); err != nil {
+			t.Fatal(err)
+		}
+
+		if refreshed := a.idleFor() < time.Minute; refreshed != tt.refresh {
+			t.Errorf("%s(%v) refreshed idle timer = %t, want %t", tt.method, tt.req, refreshed, tt.refresh)
+		}
+	}
+
+	atomic.StoreInt64(&a.lastActivity, 0)
+	if _, err := a.Unlock(ctx, &pb.UnlockRequest{Password: []byte(testMasterPassword)}); err != nil {
+		t.Fatalf("Unlock() error = %v", err)
+	}
+	if a.idleFor() >= time.Minute {
+		t.Error("Unlock() didn't refresh idle timer")
+	}
+}
+
+func TestSyncChanges(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	if _, err := a.accountsStorage.CreateAccount(ctx, &pb.Account{ID: "account", UserName: "alice"}); err != nil {
+		t.Fatal(err)
+	}
+
+	id := createSecret(t, a, &pb.Secret{Data: []byte("local")})
+
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].GetOperation() != pb.SyncOperation_CREATE {
+		t.Fatalf("localChanges() = %v, want CREATE of %s", changes, id)
+	}
+
+	// The server acknowledges the pushed secret with its first revision
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, Revision: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err = a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Fatalf("localChanges() after ack = %v, want none", changes)
+	}
+
+	// The newer server revision replaces the synced secret
+	remote, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote.Revision = 2
+	remote.Data, err = a.encrypt([]byte("remote"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.applyPulled(ctx, []*pb.Secret{remote}, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	if got := string(getSecret(t, a, id).GetData()); got != "remote" {
+		t.Errorf("GetSecret() after pull data = %q, want %q", got, "remote")
+	}
+
+	cursor, err := a.accountsStorage.GetSyncCursor(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cursor != 2 {
+		t.Errorf("GetSyncCursor() = %d, want 2", cursor)
+	}
+
+	// Both sides change the secret, the server side is kept as the conflict
+	if _, err := a.UpdateSecret(ctx, &pb.UpdateSecretRequest{Secret: &pb.Secret{ID: id, Data: []byte("changed locally")}}); err != nil {
+		t.Fatal(err)
+	}
+
+	remote.Revision = 3
+	if err := a.applyPulled(ctx, []*pb.Secret{remote}, 2, 3); err != nil {
+		t.Fatal(err)
+	}
+
+	conflicts, err := a.conflictsStorage.ListSecretConflicts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 1 || conflicts[0].GetRemote().GetRevision() != 3 {
+		t.Fatalf("ListSecretConflicts() = %v, want conflict of revision 3", conflicts)
+	}
+	if got := string(getSecret(t, a, id).GetData()); got != "changed locally" {
+		t.Errorf("GetSecret() after conflict data = %q, want %q", got, "changed locally")
+	}
+
+	changes, err = a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("localChanges() of conflicted secret = %v, want none", changes)
+	}
+}
+
+func TestSyncSameContent(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := createSecret(t, a, &pb.Secret{Data: []byte("restored"), Labels: map[string]string{"site": "example.com"}})
+
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The change rejected by the server stays unsynced
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, Error: "secret already exists"}); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err := a.localChanges(ctx); err != nil || len(changes) != 1 {
+		t.Fatalf("localChanges() after rejected change = %v, %v, want the change again", changes, err)
+	}
+
+	// The same secret is restored on another device, the content is sealed with another nonce
+	remote, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote.Revision = 1
+	remote.Data, err = a.encrypt([]byte("restored"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, Conflict: remote}); err != nil {
+		t.Fatal(err)
+	}
+
+	conflicts, err := a.conflictsStorage.ListSecretConflicts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 0 {
+		t.Errorf("ListSecretConflicts() of the same content = %v, want none", conflicts)
+	}
+
+	secret := getSecret(t, a, id)
+	if !secret.GetStatus().GetSynced() || secret.GetRevision() != 1 {
+		t.Errorf("GetSecret() status = %v, revision = %d, want synced revision 1", secret.GetStatus(), secret.GetRevision())
+	}
+}
+
+func TestSyncNotFound(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := createSecret(t, a, &pb.Secret{Data: []byte("local")})
+
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, Revision: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	// The secret is edited locally after the server purged it
+	secret := getSecret(t, a, id)
+	secret.Status.Synced = false
+	if err := a.secretsStorage.ReplaceSecret(ctx, secret); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err = a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].GetOperation() != pb.SyncOperation_UPDATE {
+		t.Fatalf("localChanges() = %v, want UPDATE of %s", changes, id)
+	}
+
+	// The acknowledgement without a revision doesn't mark the change synced
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id}); err != nil {
+		t.Fatal(err)
+	}
+	if changes, err := a.localChanges(ctx); err != nil || len(changes) != 1 {
+		t.Fatalf("localChanges() after ack without revision = %v, %v, want the change again", changes, err)
+	}
+
+	// The secret missing on the server is pushed again as a new one
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, NotFound: true}); err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err = a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].GetOperation() != pb.SyncOperation_CREATE {
+		t.Fatalf("localChanges() after not found ack = %v, want CREATE of %s", changes, id)
+	}
+	if changes[0].GetSecret().GetRevision() != 0 {
+		t.Errorf("localChanges() revision = %d, want 0", changes[0].GetSecret().GetRevision())
+	}
+}
+
+// newConflict returns the ID of the secret updated locally to "local" while the server has it updated to "remote"
+func newConflict(t *testing.T, a *agent) string {
+	t.Helper()
+	ctx := context.Background()
+
+	id := createSecret(t, a, &pb.Secret{Data: []byte("created")})
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, Revision: 1}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := a.UpdateSecret(ctx, &pb.UpdateSecretRequest{Secret: &pb.Secret{ID: id, Data: []byte("local")}}); err != nil {
+		t.Fatalf("UpdateSecret() error = %v", err)
+	}
+
+	remote, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	remote.Revision = 2
+	remote.Data, err = a.encrypt([]byte("remote"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changes, err = a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.applyAck(ctx, changes[0].GetSecret(), &pb.SyncAck{Id: id, Conflict: remote}); err != nil {
+		t.Fatal(err)
+	}
+
+	return id
+}
+
+func resolveConflict(t *testing.T, a *agent, id string, resolution pb.Resolution) *pb.ResolveSecretConflictResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	resp, err := a.ResolveSecretConflict(ctx, &pb.ResolveSecretConflictRequest{Id: id, Resolution: resolution})
+	if err != nil {
+		t.Fatalf("ResolveSecretConflict(%s) error = %v", resolution, err)
+	}
+
+	conflicts, err := a.conflictsStorage.ListSecretConflicts(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conflicts) != 0 {
+		t.Errorf("ListSecretConflicts() after %s = %v, want none", resolution, conflicts)
+	}
+
+	return resp
+}
+
+func TestResolveKeepLocal(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := newConflict(t, a)
+	resolveConflict(t, a, id, pb.Resolution_KEEP_LOCAL)
+
+	// The local side is pushed on top of the remote revision
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].GetOperation() != pb.SyncOperation_UPDATE {
+		t.Fatalf("localChanges() = %v, want UPDATE of %s", changes, id)
+	}
+	if revision := changes[0].GetSecret().GetRevision(); revision != 2 {
+		t.Errorf("localChanges() revision = %d, want the remote revision 2", revision)
+	}
+
+	if secret := getSecret(t, a, id); string(secret.GetData()) != "local" {
+		t.Errorf("GetSecret() data = %q, want %q", secret.GetData(), "local")
+	}
+}
+
+func TestResolveKeepRemote(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := newConflict(t, a)
+	resolveConflict(t, a, id, pb.Resolution_KEEP_REMOTE)
+
+	secret := getSecret(t, a, id)
+	if string(secret.GetData()) != "remote" {
+		t.Errorf("GetSecret() data = %q, want %q", secret.GetData(), "remote")
+	}
+	if !secret.GetStatus().GetSynced() || secret.GetRevision() != 2 {
+		t.Errorf("GetSecret() status = %v, revision = %d, want synced revision 2", secret.GetStatus(), secret.GetRevision())
+	}
+
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 0 {
+		t.Errorf("localChanges() after keeping remote = %v, want none", changes)
+	}
+
+	// The discarded local side stays in the history
+	versions, err := a.versionsStorage.ListSecretVersions(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(versions) == 0 {
+		t.Fatal("ListSecretVersions() = none, want the local side")
+	}
+	data, err := a.decrypt(versions[0].GetSecret().GetData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != "local" {
+		t.Errorf("ListSecretVersions()[0] data = %q, want %q", data, "local")
+	}
+}
+
+func TestResolveKeepBoth(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := newConflict(t, a)
+	resp := resolveConflict(t, a, id, pb.Resolution_KEEP_BOTH)
+	if resp.GetCopyId() == "" || resp.GetCopyId() == id {
+		t.Fatalf("ResolveSecretConflict() copy ID = %q, want new ID", resp.GetCopyId())
+	}
+
+	if secret := getSecret(t, a, id); string(secret.GetData()) != "remote" || !secret.GetStatus().GetSynced() {
+		t.Errorf("GetSecret() = %q, status = %v, want synced remote side", secret.GetData(), secret.GetStatus())
+	}
+	if secret := getSecret(t, a, resp.GetCopyId()); string(secret.GetData()) != "local" {
+		t.Errorf("GetSecret() of copy data = %q, want %q", secret.GetData(), "local")
+	}
+
+	// The copy is pushed as a new secret
+	changes, err := a.localChanges(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changes) != 1 || changes[0].GetOperation() != pb.SyncOperation_CREATE ||
+		changes[0].GetSecret().GetID() != resp.GetCopyId() || changes[0].GetSecret().GetRevision() != 0 {
+		t.Errorf("localChanges() = %v, want CREATE of %s with revision 0", changes, resp.GetCopyId())
+	}
+}
+
+func TestResolveConflictErrors(t *testing.T) {
+	ctx := context.Background()
+	a := newTestAgent(t)
+
+	id := newConflict(t, a)
+
+	_, err := a.ResolveSecretConflict(ctx, &pb.ResolveSecretConflictRequest{Id: id})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("ResolveSecretConflict() without resolution error = %v, want %v", err, ErrUnknownResolution)
+	}
+
+	id = createSecret(t, a, &pb.Secret{Data: []byte("data")})
+	_, err = a.ResolveSecretConflict(ctx, &pb.ResolveSecretConflictRequest{Id: id, Resolution: pb.Resolution_KEEP_LOCAL})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("ResolveSecretConflict() without conflict error = %v, want %v", err, codes.NotFound)
+	}
+}
@@ -0,0 +1,108 @@
+package agent
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+var (
+	ErrUnknownResolution = status.Error(codes.InvalidArgument, "unknown conflict resolution")
+)
+
+// ListSecretConflicts returns secrets changed both locally and on the server since the last sync
+func (a *agent) ListSecretConflicts(ctx context.Context, _ *pb.ListSecretConflictsRequest) (*pb.ListSecretConflictsResponse, error) {
+	conflicts, err := a.conflictsStorage.ListSecretConflicts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, conflict := range conflicts {
+		conflict.Local, err = a.secretsStorage.GetSecret(ctx, conflict.GetRemote().GetID())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListSecretConflictsResponse{
+		Error:     "",
+		Conflicts: conflicts,
+	}, nil
+}
+
+// ResolveSecretConflict keeps the local side, the remote side or both of them as separate secrets.
+// The discarded local side stays in the secret history
+func (a *agent) ResolveSecretConflict(ctx context.Context, request *pb.ResolveSecretConflictRequest) (*pb.ResolveSecretConflictResponse, error) {
+	log.Info().Msgf("ResolveSecretConflict secret %s with %s", request.GetId(), request.GetResolution())
+
+	conflict, err := a.conflictsStorage.GetSecretConflict(ctx, request.GetId())
+	if errors.Is(err, local.ErrNoSecretConflictFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	localSecret, err := a.secretsStorage.GetSecret(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	remote := conflict.GetRemote()
+
+	var copyID string
+	switch request.GetResolution() {
+	case pb.Resolution_KEEP_LOCAL:
+		// Push the local side on top of the remote revision
+		localSecret.Revision = remote.GetRevision()
+		localSecret.UpdatedAt = timestamppb.Now()
+		localSecret.Status.Synced = false
+
+		if err := a.updateSecret(ctx, localSecret); err != nil {
+			return nil, err
+		}
+	case pb.Resolution_KEEP_REMOTE:
+		if err := a.updateSecret(ctx, remote); err != nil {
+			return nil, err
+		}
+	case pb.Resolution_KEEP_BOTH:
+		// The local side is created as a new secret unless it was deleted
+		if !localSecret.GetStatus().GetDeleted() {
+			localCopy := proto.Clone(localSecret).(*pb.Secret)
+			localCopy.ID = uuid.New().String()
+			localCopy.CreatedAt = timestamppb.Now()
+			localCopy.UpdatedAt = nil
+			localCopy.Revision = 0
+			localCopy.Status = &pb.Status{}
+
+			copyID, err = a.secretsStorage.CreateSecret(ctx, localCopy)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := a.updateSecret(ctx, remote); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, ErrUnknownResolution
+	}
+
+	if err := a.conflictsStorage.DeleteSecretConflict(ctx, request.GetId()); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResolveSecretConflictResponse{
+		Error:  "",
+		Id:     request.GetId(),
+		CopyId: copyID,
+	}, nil
+}
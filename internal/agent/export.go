@@ -0,0 +1,142 @@
+package agent
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+// ExportSecrets returns all secrets including deleted ones with their prior versions decrypted,
+// one-time password seeds are decrypted too, so the export can be restored into any vault
+func (a *agent) ExportSecrets(ctx context.Context, _ *pb.ExportSecretsRequest) (*pb.ExportSecretsResponse, error) {
+	log.Info().Msg("ExportSecrets")
+
+	_, decrypt, err := a.crypto()
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := a.secretsStorage.ListSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exported := make([]*pb.ExportedSecret, 0, len(secrets))
+	for _, secret := range secrets {
+		if err := openSecret(secret, decrypt); err != nil {
+			return nil, err
+		}
+
+		versions, err := a.versionsStorage.ListSecretVersions(ctx, secret.GetID())
+		if err != nil {
+			return nil, err
+		}
+		for _, version := range versions {
+			if err := openSecret(version.GetSecret(), decrypt); err != nil {
+				return nil, err
+			}
+		}
+
+		exported = append(exported, &pb.ExportedSecret{
+			Secret:   secret,
+			Versions: versions,
+		})
+	}
+
+	return &pb.ExportSecretsResponse{
+		Error:   "",
+		Secrets: exported,
+	}, nil
+}
+
+// RestoreSecrets creates exported secrets with their IDs, timestamps and prior versions.
+// Secrets already existing in the vault are skipped, restored secrets are pushed to the server as new ones
+func (a *agent) RestoreSecrets(ctx context.Context, request *pb.RestoreSecretsRequest) (*pb.RestoreSecretsResponse, error) {
+	log.Info().Msgf("RestoreSecrets %d secrets", len(request.GetSecrets()))
+
+	encrypt, _, err := a.crypto()
+	if err != nil {
+		return nil, err
+	}
+
+	var secrets []*pb.Secret
+	var versions []*pb.SecretVersion
+	var ids, skipped []string
+	for _, exported := range request.GetSecrets() {
+		secret := exported.GetSecret()
+		if secret == nil {
+			continue
+		}
+
+		if secret.GetID() == "" {
+			secret.ID = uuid.New().String()
+		}
+
+		_, err := a.secretsStorage.GetSecret(ctx, secret.GetID())
+		if err == nil {
+			skipped = append(skipped, secret.GetID())
+			continue
+		}
+		if !errors.Is(err, local.ErrNoSecretFound) {
+			return nil, err
+		}
+
+		if secret.GetCreatedAt() == nil {
+			secret.CreatedAt = timestamppb.Now()
+		}
+		secret.Revision = 0
+		secret.Status = &pb.Status{
+			Synced:  false,
+			Deleted: secret.GetStatus().GetDeleted(),
+		}
+
+		if err := sealSecret(secret, encrypt); err != nil {
+			return nil, err
+		}
+
+		for _, version := range exported.GetVersions() {
+			if version.GetSecret() == nil {
+				continue
+			}
+
+			version.Secret.ID = secret.GetID()
+			if err := sealSecret(version.GetSecret(), encrypt); err != nil {
+				return nil, err
+			}
+			versions = append(versions, version)
+		}
+
+		secrets = append(secrets, secret)
+		ids = append(ids, secret.GetID())
+	}
+
+	if err := a.secretsStorage.RestoreSecrets(ctx, secrets, versions); err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreSecretsResponse{
+		Error:   "",
+		Ids:     ids,
+		Skipped: skipped,
+	}, nil
+}
+
+// openSecret decrypts data and all sensitive payload fields of the secret
+func openSecret(secret *pb.Secret, decrypt func([]byte) ([]byte, error)) error {
+	if len(secret.GetData()) > 0 {
+		var err error
+		secret.Data, err = decrypt(secret.GetData())
+		if err != nil {
+			return err
+		}
+	}
+
+	return payload.OpenAll(secret, decrypt)
+}
@@ -0,0 +1,187 @@
+package agent
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const (
+	minLockCheckInterval = time.Second
+)
+
+var (
+	ErrAgentLocked = status.Error(codes.FailedPrecondition, "agent is locked, run gpwd agent unlock")
+)
+
+// keyMethods use the vault key, only they keep the agent unlocked.
+// GetSecret uses the key only when the secret is decrypted
+var keyMethods = map[string]struct{}{
+	"/proto.Secrets/CreateSecret":   {},
+	"/proto.Secrets/CreateSecrets":  {},
+	"/proto.Secrets/UpdateSecret":   {},
+	"/proto.Secrets/GetSecretOTP":   {},
+	"/proto.Secrets/ExportSecrets":  {},
+	"/proto.Secrets/RestoreSecrets": {},
+	"/proto.Accounts/CreateAccount": {},
+	"/proto.Accounts/UpdateAccount": {},
+}
+
+// setDataKey makes the vault key available to RPCs
+func (a *agent) setDataKey(dataKey []byte) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	return a.replaceDataKey(dataKey)
+}
+
+// replaceDataKey replaces the data encryption key, the caller holds the lock
+func (a *agent) replaceDataKey(dataKey []byte) error {
+	encrypt, decrypt, err := encryption.GetCrypto(dataKey)
+	if err != nil {
+		return err
+	}
+
+	wipe(a.dataKey)
+	a.dataKey = dataKey
+	a.encrypt = encrypt
+	a.decrypt = decrypt
+
+	return nil
+}
+
+// lock wipes the vault key from memory
+func (a *agent) lock() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.dataKey == nil {
+		return
+	}
+
+	wipe(a.dataKey)
+	a.dataKey = nil
+	a.encrypt = nil
+	a.decrypt = nil
+
+	log.Info().Msg("Agent locked")
+}
+
+// crypto returns vault encryption routines or ErrAgentLocked
+func (a *agent) crypto() (func([]byte) ([]byte, error), func([]byte) ([]byte, error), error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.dataKey == nil {
+		return nil, nil, ErrAgentLocked
+	}
+
+	return a.encrypt, a.decrypt, nil
+}
+
+func (a *agent) touch() {
+	atomic.StoreInt64(&a.lastActivity, time.Now().UnixNano())
+}
+
+func (a *agent) idleFor() time.Duration {
+	return time.Since(time.Unix(0, atomic.LoadInt64(&a.lastActivity)))
+}
+
+// lockWorker locks the agent after LockTimeout of inactivity
+func (a *agent) lockWorker(ctx context.Context) {
+	if a.cfg.LockTimeout <= 0 {
+		return
+	}
+
+	interval := a.cfg.LockTimeout / 10
+	if interval < minLockCheckInterval {
+		interval = minLockCheckInterval
+	}
+
+	lockTicker := time.NewTicker(interval)
+	defer lockTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-lockTicker.C:
+			if a.idleFor() >= a.cfg.LockTimeout {
+				a.lock()
+			}
+		}
+	}
+}
+
+// activityUnaryInterceptor refreshes the idle timer on RPCs using the vault key,
+// listing or reading sealed secrets doesn't postpone the lock
+func (a *agent) activityUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if usesKey(info.FullMethod, req) {
+		a.touch()
+	}
+
+	return handler(ctx, req)
+}
+
+func usesKey(method string, req interface{}) bool {
+	if _, ok := keyMethods[method]; ok {
+		return true
+	}
+
+	request, ok := req.(*pb.GetSecretRequest)
+
+	return ok && request.GetDecrypt()
+}
+
+// Lock wipes the vault key, secrets could not be created or updated until Unlock
+func (a *agent) Lock(_ context.Context, _ *pb.LockRequest) (*pb.LockResponse, error) {
+	a.lock()
+
+	return &pb.LockResponse{
+		Error: "",
+	}, nil
+}
+
+// Unlock unwraps the vault key with the master password
+func (a *agent) Unlock(ctx context.Context, request *pb.UnlockRequest) (*pb.UnlockResponse, error) {
+	vault, err := a.vaultStorage.GetVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := openDataKey(vault, request.GetPassword())
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if err := a.setDataKey(dataKey); err != nil {
+		return nil, err
+	}
+	// The idle timer isn't refreshed while the agent is locked
+	a.touch()
+
+	log.Info().Msg("Agent unlocked")
+
+	return &pb.UnlockResponse{
+		Error: "",
+	}, nil
+}
+
+func wipe(data []byte) {
+	for i := range data {
+		data[i] = 0
+	}
+}
@@ -97,6 +97,114 @@ func _000004_create_sync_columns_up_sql() ([]byte, error) {
 	)
 }
 
+var __000005_create_vault_table_down_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x1b\x00\xe4\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x76\x61\x75\x6c\x74\x3b\x03\x00\xa6\xc4\x1a\xc0\x1b\x00\x00\x00")
+
+func _000005_create_vault_table_down_sql() ([]byte, error) {
+	return bindata_read(
+		__000005_create_vault_table_down_sql,
+		"000005_create_vault_table.down.sql",
+	)
+}
+
+var __000005_create_vault_table_up_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x74\x8d\xc1\xca\x82\x40\x18\x45\xf7\x3e\xc5\x5d\x2a\xfc\x9b\x7f\x1d\x2d\x54\xbe\x6a\x70\xd2\x18\xbf\x40\x97\x03\x33\xd2\x90\x43\x30\x4e\x42\x6f\x1f\x18\x04\x06\x6e\xcf\xb9\x9c\x5b\x2a\xca\x99\xc0\x79\x21\x09\xe2\x80\xba\x61\x50\x27\x5a\x6e\x31\xeb\xe7\x18\x91\x26\x00\xe0\x0c\x44\xcd\x74\x24\x85\x8b\x12\xe7\x5c\xf5\xa8\xa8\x47\x79\xa2\xb2\x42\xea\x0c\xf6\xf8\xcf\xfe\x96\xe9\xdd\x0c\x60\xea\x78\x49\xd5\x57\x29\x3f\x78\xd2\x63\x44\x21\x9b\xe2\x87\x47\xe7\xed\xb7\xbd\x56\xde\xfa\x47\x78\x6d\xc8\x78\x0b\x56\x9b\x69\xc3\xce\x36\xb8\xc1\xd9\xb0\x7e\x4c\xb2\xdd\x7b\x00\xd0\xa7\x6a\x42\xf0\x00\x00\x00")
+
+func _000005_create_vault_table_up_sql() ([]byte, error) {
+	return bindata_read(
+		__000005_create_vault_table_up_sql,
+		"000005_create_vault_table.up.sql",
+	)
+}
+
+var __000006_create_wrapped_key_columns_down_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x58\x00\xa7\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x76\x61\x75\x6c\x74\x0a\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x77\x72\x61\x70\x70\x65\x64\x5f\x6b\x65\x79\x3b\x0a\x0a\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x76\x61\x75\x6c\x74\x0a\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x72\x65\x63\x6f\x76\x65\x72\x79\x5f\x6b\x65\x79\x3b\x0a\x03\x00\xb4\x5b\xfa\x63\x58\x00\x00\x00")
+
+func _000006_create_wrapped_key_columns_down_sql() ([]byte, error) {
+	return bindata_read(
+		__000006_create_wrapped_key_columns_down_sql,
+		"000006_create_wrapped_key_columns.down.sql",
+	)
+}
+
+var __000006_create_wrapped_key_columns_up_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x7a\x00\x85\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x76\x61\x75\x6c\x74\x0a\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x77\x72\x61\x70\x70\x65\x64\x5f\x6b\x65\x79\x20\x42\x4c\x4f\x42\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x4e\x55\x4c\x4c\x3b\x0a\x0a\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x76\x61\x75\x6c\x74\x0a\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x72\x65\x63\x6f\x76\x65\x72\x79\x5f\x6b\x65\x79\x20\x42\x4c\x4f\x42\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x4e\x55\x4c\x4c\x3b\x0a\x03\x00\xbd\xc2\xc1\xff\x7a\x00\x00\x00")
+
+func _000006_create_wrapped_key_columns_up_sql() ([]byte, error) {
+	return bindata_read(
+		__000006_create_wrapped_key_columns_up_sql,
+		"000006_create_wrapped_key_columns.up.sql",
+	)
+}
+
+var __000007_create_payload_column_down_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x29\x00\xd6\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x63\x72\x65\x74\x73\x0a\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x70\x61\x79\x6c\x6f\x61\x64\x3b\x0a\x03\x00\xd8\xf1\xf9\xbe\x29\x00\x00\x00")
+
+func _000007_create_payload_column_down_sql() ([]byte, error) {
+	return bindata_read(
+		__000007_create_payload_column_down_sql,
+		"000007_create_payload_column.down.sql",
+	)
+}
+
+var __000007_create_payload_column_up_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x3a\x00\xc5\xff\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x63\x72\x65\x74\x73\x0a\x41\x44\x44\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x70\x61\x79\x6c\x6f\x61\x64\x20\x42\x4c\x4f\x42\x20\x44\x45\x46\x41\x55\x4c\x54\x20\x4e\x55\x4c\x4c\x3b\x0a\x03\x00\xc0\xf4\x1a\xa1\x3a\x00\x00\x00")
+
+func _000007_create_payload_column_up_sql() ([]byte, error) {
+	return bindata_read(
+		__000007_create_payload_column_up_sql,
+		"000007_create_payload_column.up.sql",
+	)
+}
+
+var __000008_create_secret_versions_table_down_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x26\x00\xd9\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x73\x65\x63\x72\x65\x74\x5f\x76\x65\x72\x73\x69\x6f\x6e\x73\x3b\x0a\x03\x00\xfc\x9f\xbb\xd0\x26\x00\x00\x00")
+
+func _000008_create_secret_versions_table_down_sql() ([]byte, error) {
+	return bindata_read(
+		__000008_create_secret_versions_table_down_sql,
+		"000008_create_secret_versions_table.down.sql",
+	)
+}
+
+var __000008_create_secret_versions_table_up_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x64\x8f\xc1\x0a\x82\x40\x10\x86\xef\x3e\xc5\x1c\x15\x7c\x83\x4e\x1a\x53\x2c\x6d\x1a\xeb\x04\x7a\x92\xc9\x5d\x48\x90\x94\xdd\x4d\xe8\xed\x03\xcd\x12\x3b\xce\xf7\x7f\x3f\x33\xb3\x57\x98\x10\x02\x25\xa9\x44\x10\x07\xc8\x72\x02\x2c\x45\x41\x05\x38\xd3\x58\xe3\xeb\xd1\x58\xd7\xf6\x0f\x07\x61\x00\x00\x0b\x6d\x35\x10\x96\x34\xf9\xd9\x55\xca\x78\x0a\x3f\x2e\x88\x8c\xf0\x88\x6a\x93\x76\x7c\x33\x9d\x9b\x7a\xb3\xde\x58\xc3\xde\xe8\x9a\xfd\x0a\x3e\x07\xfd\x0f\x35\x7b\x86\x54\xe6\xe9\x3c\x0e\xfc\xea\x7a\xd6\x2b\xc2\xb6\xb9\xb7\xe3\xaf\xb6\x59\x7d\x51\xe2\x9c\xa8\x0a\x4e\x58\x41\xf8\x7d\x21\x5e\x0e\x8e\x82\x68\x17\xbc\x07\x00\xe3\x7a\xe7\x92\x0b\x01\x00\x00")
+
+func _000008_create_secret_versions_table_up_sql() ([]byte, error) {
+	return bindata_read(
+		__000008_create_secret_versions_table_up_sql,
+		"000008_create_secret_versions_table.up.sql",
+	)
+}
+
+var __000009_create_revision_column_down_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x52\x00\xad\xff\x44\x52\x4f\x50\x20\x54\x41\x42\x4c\x45\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x73\x65\x63\x72\x65\x74\x5f\x63\x6f\x6e\x66\x6c\x69\x63\x74\x73\x3b\x0a\x0a\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x73\x65\x63\x72\x65\x74\x73\x0a\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x72\x65\x76\x69\x73\x69\x6f\x6e\x3b\x0a\x03\x00\x94\xb4\x21\x27\x52\x00\x00\x00")
+
+func _000009_create_revision_column_down_sql() ([]byte, error) {
+	return bindata_read(
+		__000009_create_revision_column_down_sql,
+		"000009_create_revision_column.down.sql",
+	)
+}
+
+var __000009_create_revision_column_up_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x52\x4d\x4f\xdc\x30\x10\xbd\xfb\x57\xbc\x9e\x00\x09\xa4\xf6\xbc\xda\x83\x97\x98\x36\xaa\x49\x50\xe2\xa8\x70\x42\x26\x9e\x14\x4b\xa9\xbd\xb2\xbd\x50\xfe\x7d\x95\x0f\x4c\xd4\xf6\x38\x6f\xe6\x7d\x25\xe6\x52\x89\x06\x8a\x1f\xa4\x40\xa4\x3e\x50\x8a\x8c\x17\x05\xae\x6b\xd9\xdd\x56\x08\xf4\x62\xa3\xf5\x0e\x65\xa5\xc4\x57\xd1\xa0\x10\x37\xbc\x93\x0a\x9f\x51\xd5\x0a\x55\x27\xe5\x8e\xb1\xab\x2b\xb4\x0b\x17\xf1\xcd\xf5\x64\xf0\x44\x83\x0f\x94\xe9\x11\xaf\x14\x08\xd6\xa5\xe0\xcd\x69\x3a\xa0\xdf\x36\x26\x78\x87\xf4\x4c\x88\x14\x5e\x28\xe0\xd5\xa6\xe7\x79\x1e\x6c\x88\x29\x93\x2f\x27\xfd\xe8\x61\xfc\xbc\x3c\x92\x33\xd6\xfd\xc4\xe9\x68\x74\xa2\x08\xed\x0c\x0c\x8d\x94\x66\x1f\x3f\x4c\x47\xbf\x58\x77\x57\x70\x95\x2b\xa1\x15\x2a\xeb\xed\xbf\xe0\xc7\x37\xd1\x88\x35\xeb\x3e\x85\x13\xa1\x6e\x16\x91\xcd\x7c\xbe\x38\x98\x47\x9d\x50\xb6\xb9\x2f\x78\x55\xac\xe6\xf3\xea\xd3\x1e\x67\x67\x17\x3b\xc6\xae\x1b\x31\x59\x2e\xdf\xb2\xbc\x99\x09\xe2\xbe\x6c\x55\xbb\xc6\x78\xec\xbd\x1b\x46\xdb\xa7\x88\x73\x06\xe0\x1d\xb6\x06\x4a\xdc\x2b\xdc\x35\xe5\x2d\x6f\x1e\xf0\x5d\x3c\x5c\xce\xfb\x51\x3f\xd1\x18\xe7\xe5\x02\xf4\x81\xde\x6d\x3f\xc0\x4d\x96\x0f\x70\x2d\xf3\x5f\x10\x87\xba\x96\x82\x57\xf9\x67\x0e\x7a\x8c\x94\x0b\xae\xb7\x3a\x69\x1c\x64\x7d\x58\xc6\xa3\x7e\x1b\xbd\x36\x1b\xe4\x9f\xa7\xf1\x17\x9f\x12\xf5\x9b\x04\x59\x9e\x5d\xec\xd8\x9f\x01\x00\xd2\x09\x0f\xb8\x76\x02\x00\x00")
+
+func _000009_create_revision_column_up_sql() ([]byte, error) {
+	return bindata_read(
+		__000009_create_revision_column_up_sql,
+		"000009_create_revision_column.up.sql",
+	)
+}
+
+var __000010_create_sync_cursor_column_down_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x00\x58\x00\xa7\xff\x44\x52\x4f\x50\x20\x49\x4e\x44\x45\x58\x20\x49\x46\x20\x45\x58\x49\x53\x54\x53\x20\x73\x65\x63\x72\x65\x74\x73\x5f\x73\x79\x6e\x63\x65\x64\x5f\x69\x64\x78\x3b\x0a\x0a\x41\x4c\x54\x45\x52\x20\x54\x41\x42\x4c\x45\x20\x61\x63\x63\x6f\x75\x6e\x74\x73\x0a\x44\x52\x4f\x50\x20\x43\x4f\x4c\x55\x4d\x4e\x20\x73\x79\x6e\x63\x5f\x63\x75\x72\x73\x6f\x72\x3b\x0a\x03\x00\x19\x56\xfa\x35\x58\x00\x00\x00")
+
+func _000010_create_sync_cursor_column_down_sql() ([]byte, error) {
+	return bindata_read(
+		__000010_create_sync_cursor_column_down_sql,
+		"000010_create_sync_cursor_column.down.sql",
+	)
+}
+
+var __000010_create_sync_cursor_column_up_sql = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x34\xcc\xb1\xaa\x83\x40\x10\x46\xe1\x7e\x9f\xe2\x2f\xef\xed\xd2\x5b\x6d\xdc\x31\x2c\x4c\x46\xd0\x11\xec\x24\x8c\x5b\xa4\x51\x70\x14\xe2\xdb\x87\x04\xd2\x9e\x03\x5f\x64\xa5\x0e\x1a\xaf\x4c\x78\x98\xad\xc7\xb2\x7b\x88\x29\xa1\x6e\x79\xb8\x0b\xfc\x5c\x6c\xb2\x63\xf3\x75\x43\x16\xa5\x1b\x75\x48\xd4\xc4\x81\x15\x17\x48\xab\x90\x81\xb9\x0a\xa1\xee\x28\x2a\x21\x4b\xa2\x11\xb9\xf9\x2e\x1a\x73\xaf\x3d\xbc\xd8\x56\x76\x9f\x3e\x56\x99\xa7\xe7\xfc\x42\x2b\xbf\x8a\x3f\x3f\x17\x2b\xf3\x7f\x15\xde\x03\x00\x62\x4a\x97\x55\x8c\x00\x00\x00")
+
+func _000010_create_sync_cursor_column_up_sql() ([]byte, error) {
+	return bindata_read(
+		__000010_create_sync_cursor_column_up_sql,
+		"000010_create_sync_cursor_column.up.sql",
+	)
+}
+
 // Asset loads and returns the asset for the given name.
 // It returns an error if the asset could not be found or
 // could not be loaded.
@@ -119,14 +227,26 @@ func AssetNames() []string {
 
 // _bindata is a table, holding each asset generator, mapped to its name.
 var _bindata = map[string]func() ([]byte, error){
-	"000001_create_secrets_table.down.sql":  _000001_create_secrets_table_down_sql,
-	"000001_create_secrets_table.up.sql":    _000001_create_secrets_table_up_sql,
-	"000002_create_dates_columns.down.sql":  _000002_create_dates_columns_down_sql,
-	"000002_create_dates_columns.up.sql":    _000002_create_dates_columns_up_sql,
-	"000003_create_accounts_table.down.sql": _000003_create_accounts_table_down_sql,
-	"000003_create_accounts_table.up.sql":   _000003_create_accounts_table_up_sql,
-	"000004_create_sync_columns.down.sql":   _000004_create_sync_columns_down_sql,
-	"000004_create_sync_columns.up.sql":     _000004_create_sync_columns_up_sql,
+	"000001_create_secrets_table.down.sql":         _000001_create_secrets_table_down_sql,
+	"000001_create_secrets_table.up.sql":           _000001_create_secrets_table_up_sql,
+	"000002_create_dates_columns.down.sql":         _000002_create_dates_columns_down_sql,
+	"000002_create_dates_columns.up.sql":           _000002_create_dates_columns_up_sql,
+	"000003_create_accounts_table.down.sql":        _000003_create_accounts_table_down_sql,
+	"000003_create_accounts_table.up.sql":          _000003_create_accounts_table_up_sql,
+	"000004_create_sync_columns.down.sql":          _000004_create_sync_columns_down_sql,
+	"000004_create_sync_columns.up.sql":            _000004_create_sync_columns_up_sql,
+	"000005_create_vault_table.down.sql":           _000005_create_vault_table_down_sql,
+	"000005_create_vault_table.up.sql":             _000005_create_vault_table_up_sql,
+	"000006_create_wrapped_key_columns.down.sql":   _000006_create_wrapped_key_columns_down_sql,
+	"000006_create_wrapped_key_columns.up.sql":     _000006_create_wrapped_key_columns_up_sql,
+	"000007_create_payload_column.down.sql":        _000007_create_payload_column_down_sql,
+	"000007_create_payload_column.up.sql":          _000007_create_payload_column_up_sql,
+	"000008_create_secret_versions_table.down.sql": _000008_create_secret_versions_table_down_sql,
+	"000008_create_secret_versions_table.up.sql":   _000008_create_secret_versions_table_up_sql,
+	"000009_create_revision_column.down.sql":       _000009_create_revision_column_down_sql,
+	"000009_create_revision_column.up.sql":         _000009_create_revision_column_up_sql,
+	"000010_create_sync_cursor_column.down.sql":    _000010_create_sync_cursor_column_down_sql,
+	"000010_create_sync_cursor_column.up.sql":      _000010_create_sync_cursor_column_up_sql,
 }
 
 // AssetDir returns the file names below a certain
@@ -170,12 +290,24 @@ type _bintree_t struct {
 }
 
 var _bintree = &_bintree_t{nil, map[string]*_bintree_t{
-	"000001_create_secrets_table.down.sql":  &_bintree_t{_000001_create_secrets_table_down_sql, map[string]*_bintree_t{}},
-	"000001_create_secrets_table.up.sql":    &_bintree_t{_000001_create_secrets_table_up_sql, map[string]*_bintree_t{}},
-	"000002_create_dates_columns.down.sql":  &_bintree_t{_000002_create_dates_columns_down_sql, map[string]*_bintree_t{}},
-	"000002_create_dates_columns.up.sql":    &_bintree_t{_000002_create_dates_columns_up_sql, map[string]*_bintree_t{}},
-	"000003_create_accounts_table.down.sql": &_bintree_t{_000003_create_accounts_table_down_sql, map[string]*_bintree_t{}},
-	"000003_create_accounts_table.up.sql":   &_bintree_t{_000003_create_accounts_table_up_sql, map[string]*_bintree_t{}},
-	"000004_create_sync_columns.down.sql":   &_bintree_t{_000004_create_sync_columns_down_sql, map[string]*_bintree_t{}},
-	"000004_create_sync_columns.up.sql":     &_bintree_t{_000004_create_sync_columns_up_sql, map[string]*_bintree_t{}},
+	"000001_create_secrets_table.down.sql":         &_bintree_t{_000001_create_secrets_table_down_sql, map[string]*_bintree_t{}},
+	"000001_create_secrets_table.up.sql":           &_bintree_t{_000001_create_secrets_table_up_sql, map[string]*_bintree_t{}},
+	"000002_create_dates_columns.down.sql":         &_bintree_t{_000002_create_dates_columns_down_sql, map[string]*_bintree_t{}},
+	"000002_create_dates_columns.up.sql":           &_bintree_t{_000002_create_dates_columns_up_sql, map[string]*_bintree_t{}},
+	"000003_create_accounts_table.down.sql":        &_bintree_t{_000003_create_accounts_table_down_sql, map[string]*_bintree_t{}},
+	"000003_create_accounts_table.up.sql":          &_bintree_t{_000003_create_accounts_table_up_sql, map[string]*_bintree_t{}},
+	"000004_create_sync_columns.down.sql":          &_bintree_t{_000004_create_sync_columns_down_sql, map[string]*_bintree_t{}},
+	"000004_create_sync_columns.up.sql":            &_bintree_t{_000004_create_sync_columns_up_sql, map[string]*_bintree_t{}},
+	"000005_create_vault_table.down.sql":           &_bintree_t{_000005_create_vault_table_down_sql, map[string]*_bintree_t{}},
+	"000005_create_vault_table.up.sql":             &_bintree_t{_000005_create_vault_table_up_sql, map[string]*_bintree_t{}},
+	"000006_create_wrapped_key_columns.down.sql":   &_bintree_t{_000006_create_wrapped_key_columns_down_sql, map[string]*_bintree_t{}},
+	"000006_create_wrapped_key_columns.up.sql":     &_bintree_t{_000006_create_wrapped_key_columns_up_sql, map[string]*_bintree_t{}},
+	"000007_create_payload_column.down.sql":        &_bintree_t{_000007_create_payload_column_down_sql, map[string]*_bintree_t{}},
+	"000007_create_payload_column.up.sql":          &_bintree_t{_000007_create_payload_column_up_sql, map[string]*_bintree_t{}},
+	"000008_create_secret_versions_table.down.sql": &_bintree_t{_000008_create_secret_versions_table_down_sql, map[string]*_bintree_t{}},
+	"000008_create_secret_versions_table.up.sql":   &_bintree_t{_000008_create_secret_versions_table_up_sql, map[string]*_bintree_t{}},
+	"000009_create_revision_column.down.sql":       &_bintree_t{_000009_create_revision_column_down_sql, map[string]*_bintree_t{}},
+	"000009_create_revision_column.up.sql":         &_bintree_t{_000009_create_revision_column_up_sql, map[string]*_bintree_t{}},
+	"000010_create_sync_cursor_column.down.sql":    &_bintree_t{_000010_create_sync_cursor_column_down_sql, map[string]*_bintree_t{}},
+	"000010_create_sync_cursor_column.up.sql":      &_bintree_t{_000010_create_sync_cursor_column_up_sql, map[string]*_bintree_t{}},
 }}
@@ -0,0 +1,80 @@
+package agent
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/otp"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var (
+	ErrNoOTPSeed = status.Error(codes.NotFound, "secret has no one-time password seed")
+)
+
+// GetSecretOTP computes the one-time password of the login seed, the seed never leaves the agent
+func (a *agent) GetSecretOTP(ctx context.Context, request *pb.GetSecretOTPRequest) (*pb.GetSecretOTPResponse, error) {
+	encrypt, decrypt, err := a.crypto()
+	if err != nil {
+		return nil, err
+	}
+
+	// HOTP counter is updated on every code
+	a.otpMu.Lock()
+	defer a.otpMu.Unlock()
+
+	secret, err := a.secretsStorage.GetSecret(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	seed, err := payload.OpenOTPSeed(secret, decrypt)
+	if err != nil {
+		return nil, err
+	}
+	if seed == nil {
+		return nil, ErrNoOTPSeed
+	}
+	defer wipe(seed)
+
+	key, err := otp.Parse(string(seed))
+	if err != nil {
+		return nil, err
+	}
+
+	code, remaining, err := key.Code(time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Type == otp.TypeHOTP {
+		key.Counter++
+
+		secret.GetLogin().TOTPSeed, err = encrypt([]byte(key.URI()))
+		if err != nil {
+			return nil, err
+		}
+
+		secret.UpdatedAt = timestamppb.Now()
+		secret.Status.Synced = false
+
+		// The counter isn't a change of the user, so the previous seed isn't archived
+		if err := a.secretsStorage.ReplaceSecret(ctx, secret); err != nil {
+			return nil, err
+		}
+	}
+
+	log.Info().Msgf("GetSecretOTP secret %s", secret.GetID())
+
+	return &pb.GetSecretOTPResponse{
+		Error:     "",
+		Code:      code,
+		Remaining: uint32(remaining / time.Second),
+	}, nil
+}
@@ -0,0 +1,127 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+)
+
+const (
+	socketMode = 0600
+)
+
+var (
+	ErrNotUnixConn      = errors.New("peer credentials are available only for unix socket connections")
+	ErrPeerNotPermitted = errors.New("peer is not permitted to use the agent")
+)
+
+// Caller describes the local process connected to the agent socket
+type Caller struct {
+	PID        int32
+	UID        uint32
+	GID        uint32
+	Executable string
+}
+
+type callerKey struct{}
+
+// CallerFromContext returns the caller attached to the request context
+func CallerFromContext(ctx context.Context) (*Caller, bool) {
+	caller, ok := ctx.Value(callerKey{}).(*Caller)
+
+	return caller, ok
+}
+
+// peerAuthInfo extends the transport auth info with the peer credentials
+type peerAuthInfo struct {
+	credentials.AuthInfo
+	caller *Caller
+}
+
+// peerCredentials rejects connections of users other than the agent owner and allowed UIDs
+// before the transport handshake
+type peerCredentials struct {
+	credentials.TransportCredentials
+	allowed map[uint32]struct{}
+}
+
+func newPeerCredentials(creds credentials.TransportCredentials, allowedUIDs []uint32) *peerCredentials {
+	allowed := map[uint32]struct{}{
+		uint32(os.Getuid()): {},
+	}
+	for _, uid := range allowedUIDs {
+		allowed[uid] = struct{}{}
+	}
+
+	return &peerCredentials{
+		TransportCredentials: creds,
+		allowed:              allowed,
+	}
+}
+
+func (pc *peerCredentials) ServerHandshake(rawConn net.Conn) (net.Conn, credentials.AuthInfo, error) {
+	unixConn, ok := rawConn.(*net.UnixConn)
+	if !ok {
+		return nil, nil, ErrNotUnixConn
+	}
+
+	caller, err := readPeerCred(unixConn)
+	if err != nil {
+		return nil, nil, fmt.Errorf("couldn't read peer credentials: %w", err)
+	}
+
+	if _, ok := pc.allowed[caller.UID]; !ok {
+		log.Error().Msgf("Rejected connection of pid %d uid %d (%s)", caller.PID, caller.UID, caller.Executable)
+		return nil, nil, ErrPeerNotPermitted
+	}
+
+	conn, authInfo, err := pc.TransportCredentials.ServerHandshake(rawConn)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return conn, &peerAuthInfo{AuthInfo: authInfo, caller: caller}, nil
+}
+
+func (pc *peerCredentials) Clone() credentials.TransportCredentials {
+	allowed := make(map[uint32]struct{}, len(pc.allowed))
+	for uid := range pc.allowed {
+		allowed[uid] = struct{}{}
+	}
+
+	return &peerCredentials{
+		TransportCredentials: pc.TransportCredentials.Clone(),
+		allowed:              allowed,
+	}
+}
+
+// callerUnaryInterceptor attaches the caller to the request context
+func (a *agent) callerUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, ErrPeerNotPermitted
+	}
+
+	authInfo, ok := p.AuthInfo.(*peerAuthInfo)
+	if !ok {
+		return nil, ErrPeerNotPermitted
+	}
+
+	caller := authInfo.caller
+	log.Debug().Msgf("%s called by pid %d uid %d (%s)", info.FullMethod, caller.PID, caller.UID, caller.Executable)
+
+	return handler(context.WithValue(ctx, callerKey{}, caller), req)
+}
@@ -0,0 +1,39 @@
+package agent
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"syscall"
+)
+
+// readPeerCred reads SO_PEERCRED of the connected process
+func readPeerCred(conn *net.UnixConn) (*Caller, error) {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return nil, err
+	}
+
+	var ucred *syscall.Ucred
+	var credErr error
+
+	err = rawConn.Control(func(fd uintptr) {
+		ucred, credErr = syscall.GetsockoptUcred(int(fd), syscall.SOL_SOCKET, syscall.SO_PEERCRED)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if credErr != nil {
+		return nil, credErr
+	}
+
+	// The executable is informational, the process may be gone or owned by another user
+	executable, _ := os.Readlink(fmt.Sprintf("/proc/%d/exe", ucred.Pid))
+
+	return &Caller{
+		PID:        ucred.Pid,
+		UID:        ucred.Uid,
+		GID:        ucred.Gid,
+		Executable: executable,
+	}, nil
+}
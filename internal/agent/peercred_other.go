@@ -0,0 +1,13 @@
+//go:build !linux
+
+package agent
+
+import (
+	"errors"
+	"net"
+)
+
+// readPeerCred fails closed where SO_PEERCRED is not available
+func readPeerCred(_ *net.UnixConn) (*Caller, error) {
+	return nil, errors.New("peer credentials are not supported on this platform")
+}
@@ -2,14 +2,26 @@ package agent
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/payload"
 	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/selector"
 	"github.com/go-rfe/gpwd/internal/storage/local"
+	"github.com/go-rfe/gpwd/internal/syncer"
 )
 
+// ErrFileTooLarge is returned for file secrets which wouldn't be synced with the server
+var ErrFileTooLarge = status.Error(codes.InvalidArgument,
+	fmt.Sprintf("file is larger than %d MiB", payload.MaxFileSize>>20))
+
 // CreateSecret creates secret
 func (a *agent) CreateSecret(ctx context.Context, request *pb.CreateSecretRequest) (*pb.CreateSecretResponse, error) {
 	secret := request.Secret
@@ -18,12 +30,19 @@ func (a *agent) CreateSecret(ctx context.Context, request *pb.CreateSecretReques
 
 	log.Info().Msgf("CreateSecret secret %s", secret.ID)
 
-	var err error
-	secret.Data, err = a.encrypt(secret.GetData())
+	if payload.FileTooLarge(secret) {
+		return nil, ErrFileTooLarge
+	}
+
+	encrypt, _, err := a.crypto()
 	if err != nil {
 		return nil, err
 	}
 
+	if err := sealSecret(secret, encrypt); err != nil {
+		return nil, err
+	}
+
 	id, err := a.secretsStorage.CreateSecret(ctx, secret)
 	if err != nil {
 		return nil, err
@@ -35,27 +54,96 @@ func (a *agent) CreateSecret(ctx context.Context, request *pb.CreateSecretReques
 	}, nil
 }
 
-// ListSecrets list secrets
-func (a *agent) ListSecrets(ctx context.Context, _ *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
-	secrets, err := a.secretsStorage.ListSecrets(ctx)
+// CreateSecrets creates secrets in bulk, e.g. on import, either all secrets are created or none of them
+func (a *agent) CreateSecrets(ctx context.Context, request *pb.CreateSecretsRequest) (*pb.CreateSecretsResponse, error) {
+	secrets := request.GetSecrets()
+
+	log.Info().Msgf("CreateSecrets %d secrets", len(secrets))
+
+	encrypt, _, err := a.crypto()
 	if err != nil {
 		return nil, err
 	}
 
-	// Filter deleted secrets
-	actualSecrets := make([]*pb.Secret, 0, len(secrets))
 	for _, secret := range secrets {
-		if !secret.Status.Deleted {
-			actualSecrets = append(actualSecrets, secret)
+		if payload.FileTooLarge(secret) {
+			return nil, ErrFileTooLarge
+		}
+	}
+
+	for _, secret := range secrets {
+		secret.ID = uuid.New().String()
+
+		if err := sealSecret(secret, encrypt); err != nil {
+			return nil, err
+		}
+	}
+
+	ids, err := a.secretsStorage.CreateSecrets(ctx, secrets)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateSecretsResponse{
+		Error: "",
+		Ids:   ids,
+	}, nil
+}
+
+// sealSecret encrypts data and sensitive payload fields of the secret
+func sealSecret(secret *pb.Secret, encrypt func([]byte) ([]byte, error)) error {
+	var err error
+	secret.Data, err = encrypt(secret.GetData())
+	if err != nil {
+		return err
+	}
+
+	return payload.Seal(secret, encrypt)
+}
+
+// sameContent reports whether the secrets have the same labels, data and payload once decrypted,
+// sealed content differs anyway as every encryption takes a fresh nonce
+func sameContent(first, second *pb.Secret, decrypt func([]byte) ([]byte, error)) (bool, error) {
+	var opened [2]*pb.Secret
+	for i, secret := range []*pb.Secret{first, second} {
+		opened[i] = proto.Clone(secret).(*pb.Secret)
+		if err := openSecret(opened[i], decrypt); err != nil {
+			return false, err
 		}
 	}
 
+	return proto.Equal(
+		&pb.Secret{Labels: opened[0].GetLabels(), Data: opened[0].GetData(), Payload: opened[0].Payload},
+		&pb.Secret{Labels: opened[1].GetLabels(), Data: opened[1].GetData(), Payload: opened[1].Payload},
+	), nil
+}
+
+// ListSecrets list secrets matching the label selector, deleted secrets are listed on request only
+func (a *agent) ListSecrets(ctx context.Context, request *pb.ListSecretsRequest) (*pb.ListSecretsResponse, error) {
+	labelSelector, err := selector.Parse(request.GetSelector())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	secrets, total, err := a.secretsStorage.QuerySecrets(ctx, local.SecretsQuery{
+		Deleted:    request.GetDeleted(),
+		Selector:   labelSelector,
+		SortBy:     request.GetSortBy(),
+		Descending: request.GetDescending(),
+		Offset:     int(request.GetOffset()),
+		Limit:      int(request.GetLimit()),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &pb.ListSecretsResponse{
-		Secrets: actualSecrets,
+		Secrets: secrets,
+		Total:   uint32(total),
 	}, nil
 }
 
-// GetSecret returns secret
+// GetSecret returns secret, the data is decrypted on request while the agent is unlocked
 func (a *agent) GetSecret(ctx context.Context, request *pb.GetSecretRequest) (*pb.GetSecretResponse, error) {
 	id := request.GetId()
 
@@ -64,6 +152,24 @@ func (a *agent) GetSecret(ctx context.Context, request *pb.GetSecretRequest) (*p
 		return nil, err
 	}
 
+	if request.GetDecrypt() {
+		_, decrypt, err := a.crypto()
+		if err != nil {
+			return nil, err
+		}
+
+		if secret.GetData() != nil {
+			secret.Data, err = decrypt(secret.GetData())
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if err := payload.Open(secret, decrypt); err != nil {
+			return nil, err
+		}
+	}
+
 	return &pb.GetSecretResponse{
 		Error:  "",
 		Secret: secret,
@@ -76,13 +182,42 @@ func (a *agent) UpdateSecret(ctx context.Context, request *pb.UpdateSecretReques
 
 	log.Info().Msgf("UpdateSecret secret %s", secret.ID)
 
-	var err error
-	secret.Data, err = a.encrypt(secret.GetData())
+	encrypt, decrypt, err := a.crypto()
 	if err != nil {
 		return nil, err
 	}
 
-	if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
+	existingSecret, err := a.secretsStorage.GetSecret(ctx, secret.GetID())
+	if err != nil {
+		return nil, err
+	}
+	if existingSecret.GetStatus().GetDeleted() {
+		return nil, ErrSecretDeleted
+	}
+
+	// The update is pushed on top of the known server revision
+	secret.Revision = existingSecret.GetRevision()
+
+	// The data of the typed secret replaces the main content of its payload, e.g. the password of the login
+	if secret.GetPayload() == nil && existingSecret.GetPayload() != nil {
+		secret.Payload = proto.Clone(existingSecret).(*pb.Secret).Payload
+		if err := payload.OpenAll(secret, decrypt); err != nil {
+			return nil, err
+		}
+
+		payload.SetContent(secret, secret.GetData())
+		secret.Data = nil
+	}
+
+	if payload.FileTooLarge(secret) {
+		return nil, ErrFileTooLarge
+	}
+
+	if err := sealUpdate(secret, existingSecret, encrypt, decrypt); err != nil {
+		return nil, err
+	}
+
+	if err := a.updateSecret(ctx, secret); err != nil {
 		return nil, err
 	}
 
@@ -92,6 +227,36 @@ func (a *agent) UpdateSecret(ctx context.Context, request *pb.UpdateSecretReques
 	}, nil
 }
 
+// sealUpdate encrypts the updated secret, the content equal to the stored one keeps its ciphertext,
+// so the label-only update isn't archived as a new version
+func sealUpdate(secret, stored *pb.Secret, encrypt, decrypt func([]byte) ([]byte, error)) error {
+	opened := proto.Clone(stored).(*pb.Secret)
+	if err := openSecret(opened, decrypt); err != nil {
+		return err
+	}
+
+	if proto.Equal(
+		&pb.Secret{Data: opened.GetData(), Payload: opened.Payload},
+		&pb.Secret{Data: secret.GetData(), Payload: secret.Payload},
+	) {
+		secret.Data = stored.GetData()
+		secret.Payload = stored.Payload
+
+		return nil
+	}
+
+	return sealSecret(secret, encrypt)
+}
+
+// updateSecret updates the secret content keeping VersionsRetention prior revisions
+func (a *agent) updateSecret(ctx context.Context, secret *pb.Secret) error {
+	if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
+		return err
+	}
+
+	return a.versionsStorage.PruneSecretVersions(ctx, secret.GetID(), a.cfg.VersionsRetention)
+}
+
 // DeleteSecret deletes secret
 func (a *agent) DeleteSecret(ctx context.Context, request *pb.DeleteSecretRequest) (*pb.DeleteSecretResponse, error) {
 	secret := request.GetSecret()
@@ -106,24 +271,54 @@ func (a *agent) DeleteSecret(ctx context.Context, request *pb.DeleteSecretReques
 	}, nil
 }
 
-// CreateAccount creates account
+// CreateAccount creates account registered on the server right away. The vault of the device joining
+// the account registered from another device is re-encrypted with the data encryption key of the account
 func (a *agent) CreateAccount(ctx context.Context, request *pb.CreateAccountRequest) (*pb.CreateAccountResponse, error) {
+	if _, _, err := a.crypto(); err != nil {
+		return nil, err
+	}
+
 	// check first if account exist
 	existingAccount, err := a.accountsStorage.GetAccount(ctx)
-	if err != nil && existingAccount == nil {
+	if err != nil && !errors.Is(err, local.ErrAccountNotExists) {
 		return nil, err
 	}
 	if existingAccount != nil {
 		return nil, local.ErrAccountExists
 	}
 
+	vault, err := a.vaultStorage.GetVault(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := openDataKey(vault, request.GetMasterPassword()); err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
 	account := request.Account
 
+	accountKey, err := syncer.Join(ctx, account, vaultKey(vault), a.serverDialOptions()...)
+	if err != nil {
+		return nil, err
+	}
+
+	if accountKey != nil {
+		if err := a.adoptVaultKey(ctx, accountKey, request.GetMasterPassword()); err != nil {
+			return nil, err
+		}
+	}
+
+	encrypt, _, err := a.crypto()
+	if err != nil {
+		return nil, err
+	}
+
 	account.ID = uuid.New().String()
 
 	log.Info().Msgf("Create account %s", account.ID)
 
-	account.UserPassword, err = a.encrypt(account.GetUserPassword())
+	account.UserPassword, err = encrypt(account.GetUserPassword())
 	if err != nil {
 		return nil, err
 	}
@@ -158,6 +353,11 @@ func (a *agent) UpdateAccount(ctx context.Context, request *pb.UpdateAccountRequ
 
 	log.Info().Msgf("Update account %s", account.ID)
 
+	encrypt, _, err := a.crypto()
+	if err != nil {
+		return nil, err
+	}
+
 	// check first if account exist
 	existingAccount, err := a.accountsStorage.GetAccount(ctx)
 	if err != nil && existingAccount == nil {
@@ -173,7 +373,7 @@ func (a *agent) UpdateAccount(ctx context.Context, request *pb.UpdateAccountRequ
 	}
 
 	if account.GetUserPassword() != nil {
-		existingAccount.UserPassword, err = a.encrypt(account.GetUserPassword())
+		existingAccount.UserPassword, err = encrypt(account.GetUserPassword())
 		if err != nil {
 			return nil, err
 		}
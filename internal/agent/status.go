@@ -0,0 +1,80 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+// recordSync keeps the outcome of the sync for SyncStatus
+func (a *agent) recordSync(err error) {
+	a.statusMu.Lock()
+	defer a.statusMu.Unlock()
+
+	if err != nil {
+		a.lastSyncErr = err
+		a.lastSyncErrAt = time.Now()
+		return
+	}
+
+	a.lastSyncAt = time.Now()
+	a.lastSyncErr = nil
+	a.lastSyncErrAt = time.Time{}
+}
+
+// SyncStatus reports the last sync outcome and local changes waiting for it
+func (a *agent) SyncStatus(ctx context.Context, _ *pb.SyncStatusRequest) (*pb.SyncStatusResponse, error) {
+	response := &pb.SyncStatusResponse{
+		Error:    "",
+		Watching: a.isWatching(),
+	}
+
+	account, err := a.accountsStorage.GetAccount(ctx)
+	if err != nil && !errors.Is(err, local.ErrAccountNotExists) {
+		return nil, err
+	}
+	if account != nil {
+		response.ServerAddress = account.GetServerAddress()
+	}
+
+	pending, err := a.secretsStorage.ListUnsyncedSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response.Pending = uint64(len(pending))
+
+	conflicts, err := a.conflictsStorage.ListSecretConflicts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	response.Conflicts = uint64(len(conflicts))
+
+	a.statusMu.RLock()
+	defer a.statusMu.RUnlock()
+
+	if !a.lastSyncAt.IsZero() {
+		response.LastSyncAt = timestamppb.New(a.lastSyncAt)
+	}
+	if a.lastSyncErr != nil {
+		response.LastError = a.lastSyncErr.Error()
+		response.LastErrorAt = timestamppb.New(a.lastSyncErrAt)
+	}
+
+	return response, nil
+}
+
+// SyncNow syncs with the server right away and reports the result
+func (a *agent) SyncNow(ctx context.Context, _ *pb.SyncNowRequest) (*pb.SyncNowResponse, error) {
+	if err := a.syncOnce(ctx); err != nil {
+		return nil, err
+	}
+
+	return &pb.SyncNowResponse{
+		Error: "",
+	}, nil
+}
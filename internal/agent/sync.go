@@ -3,15 +3,19 @@ package agent
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"time"
 
+	"google.golang.org/grpc"
+
 	"github.com/go-rfe/gpwd/internal/logging/log"
 	pb "github.com/go-rfe/gpwd/internal/proto"
 	"github.com/go-rfe/gpwd/internal/storage/local"
 	"github.com/go-rfe/gpwd/internal/syncer"
 )
 
+// syncWorker syncs on requests and polls the server every SyncInterval unless the server pushes changes
 func (a *agent) syncWorker(ctx context.Context) {
 	registerTicker := time.NewTicker(a.cfg.SyncInterval)
 	defer registerTicker.Stop()
@@ -21,30 +25,45 @@ func (a *agent) syncWorker(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-registerTicker.C:
-			syncCtx, cancel := context.WithTimeout(ctx, a.cfg.SyncInterval)
-
-			client, err := a.getSyncClient(syncCtx)
-			if err != nil {
-				log.Error().Err(err).Msg("a error occurred during sync client creation")
-				cancel()
+			if a.isWatching() {
 				continue
 			}
+			_ = a.syncOnce(ctx)
+		case <-a.syncRequests:
+			_ = a.syncOnce(ctx)
+		}
+	}
+}
 
-			if err := a.syncDeleted(ctx, client); err != nil {
-				log.Error().Err(err).Msg("a error occurred during sync deleted secrets")
-			}
-			if err := a.syncUpdated(ctx, client); err != nil {
-				log.Error().Err(err).Msg("a error occurred during sync updated secrets")
-			}
-			if err := a.syncCreated(ctx, client); err != nil {
-				log.Error().Err(err).Msg("a error occurred during sync created secrets")
-			}
-			if err := a.sync(ctx, client); err != nil {
-				log.Error().Err(err).Msg("a error occurred during sync secrets")
-			}
+// syncOnce syncs with the server, syncs made by the worker and on demand don't overlap
+func (a *agent) syncOnce(ctx context.Context) error {
+	a.syncMu.Lock()
+	defer a.syncMu.Unlock()
 
-			cancel()
-		}
+	syncCtx, cancel := context.WithTimeout(ctx, a.cfg.SyncInterval)
+	defer cancel()
+
+	client, err := a.getSyncClient(syncCtx)
+	if err != nil {
+		log.Error().Err(err).Msg("a error occurred during sync client creation")
+		a.recordSync(err)
+		return err
+	}
+
+	err = a.sync(ctx, client)
+	if err != nil {
+		log.Error().Err(err).Msg("a error occurred during sync secrets")
+	}
+	a.recordSync(err)
+
+	return err
+}
+
+// requestSync makes syncWorker sync as soon as possible, requests made during sync are coalesced
+func (a *agent) requestSync() {
+	select {
+	case a.syncRequests <- struct{}{}:
+	default:
 	}
 }
 
@@ -54,19 +73,31 @@ func (a *agent) getSyncClient(ctx context.Context) (pb.SyncClient, error) {
 		return nil, err
 	}
 
-	registered := account.Registered
+	password, err := a.syncAccountPassword(account)
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the stored account password encrypted
+	syncAccount := &pb.Account{
+		ServerAddress: account.GetServerAddress(),
+		UserName:      account.GetUserName(),
+		UserPassword:  password,
+		Registered:    account.GetRegistered(),
+	}
 
-	account.UserPassword, err = a.decrypt(account.GetUserPassword())
+	vault, err := a.vaultStorage.GetVault(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	client, err := syncer.NewSyncer(ctx, account)
+	client, err := syncer.NewSyncer(ctx, syncAccount, vaultKey(vault), a.serverDialOptions()...)
 	if err != nil {
 		return nil, err
 	}
 
-	if account.Registered && !registered {
+	if syncAccount.Registered && !account.Registered {
+		account.Registered = true
 		if err := a.accountsStorage.UpdateAccount(ctx, account); err != nil {
 			return nil, err
 		}
@@ -75,123 +106,310 @@ func (a *agent) getSyncClient(ctx context.Context) (pb.SyncClient, error) {
 	return client, err
 }
 
-func (a *agent) syncDeleted(ctx context.Context, client pb.SyncClient) error {
-	secrets, err := a.secretsStorage.ListSecrets(ctx)
-	if err != nil {
-		return err
+// serverDialOptions returns options of server connections on top of the syncer defaults
+func (a *agent) serverDialOptions() []grpc.DialOption {
+	if a.cfg.ServerDialer == nil {
+		return nil
 	}
 
-	stream, err := client.SyncDeleted(ctx)
-	for _, secret := range secrets {
-		if secret.Status.Deleted && !secret.Status.Synced {
-			err = stream.Send(&pb.SyncRequest{Secret: secret})
-			if err != nil {
-				return err
-			}
-
-			secret.Status.Synced = true
+	return []grpc.DialOption{grpc.WithContextDialer(a.cfg.ServerDialer)}
+}
 
-			if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
-				return err
-			}
+// syncAccountPassword decrypts the server password while the agent is unlocked
+// and keeps it in memory, so sync of already encrypted secrets continues while locked
+func (a *agent) syncAccountPassword(account *pb.Account) ([]byte, error) {
+	_, decrypt, err := a.crypto()
+	if err == nil {
+		password, err := decrypt(account.GetUserPassword())
+		if err != nil {
+			return nil, err
 		}
+
+		a.mu.Lock()
+		a.syncPassword = password
+		a.mu.Unlock()
+
+		return password, nil
+	}
+
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+
+	if a.syncPassword == nil {
+		return nil, err
 	}
-	recv, err := stream.CloseAndRecv()
+
+	return a.syncPassword, nil
+}
+
+// sync pushes secrets changed locally and pulls secrets changed on the server in a single stream.
+// Pushed secrets are marked synced once the server acknowledges the commit, so an interrupted sync
+// is resumed by the next one
+func (a *agent) sync(ctx context.Context, client pb.SyncClient) error {
+	changes, err := a.localChanges(ctx)
 	if err != nil {
 		return err
 	}
-	if recv.GetError() != "" {
-		return errors.New(recv.GetError())
+
+	since, err := a.accountsStorage.GetSyncCursor(ctx)
+	if err != nil {
+		return err
 	}
 
-	return nil
-}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-func (a *agent) syncUpdated(ctx context.Context, client pb.SyncClient) error {
-	secrets, err := a.secretsStorage.ListSecrets(ctx)
+	stream, err := client.Sync(ctx)
 	if err != nil {
 		return err
 	}
 
-	stream, err := client.SyncUpdated(ctx)
-	for _, secret := range secrets {
-		if !secret.Status.Deleted && !secret.Status.Synced && secret.UpdatedAt != nil {
-			err = stream.Send(&pb.SyncRequest{Secret: secret})
-			if err != nil {
-				return err
-			}
+	pending := make(map[string]*pb.Secret, len(changes))
+	for _, change := range changes {
+		pending[change.GetSecret().GetID()] = change.GetSecret()
+	}
 
-			secret.Status.Synced = true
+	// Acknowledgements are received while sending, so neither side blocks on flow control
+	received := make(chan pullResult, 1)
+	go func() {
+		secrets, cursor, err := a.receive(ctx, stream, pending, since)
+		if err != nil {
+			// Unblock sending
+			cancel()
+		}
+		received <- pullResult{secrets: secrets, cursor: cursor, err: err}
+	}()
 
-			if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
-				return err
+	sendErr := a.send(stream, changes, since)
+	if sendErr != nil {
+		cancel()
+	}
+
+	result := <-received
+	if result.err != nil {
+		return result.err
+	}
+	if sendErr != nil {
+		return sendErr
+	}
+
+	return a.applyPulled(ctx, result.secrets, since, result.cursor)
+}
+
+type pullResult struct {
+	secrets []*pb.Secret
+	cursor  uint64
+	err     error
+}
+
+// receive applies acknowledgements of pushed secrets as they arrive and collects pulled secrets
+func (a *agent) receive(ctx context.Context, stream pb.Sync_SyncClient, pending map[string]*pb.Secret, cursor uint64) ([]*pb.Secret, uint64, error) {
+	var pulled []*pb.Secret
+	for {
+		message, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return pulled, cursor, nil
+		}
+		if err != nil {
+			return nil, 0, err
+		}
+		if message.GetError() != "" {
+			return nil, 0, errors.New(message.GetError())
+		}
+
+		if ack := message.GetAck(); ack != nil {
+			if err := a.applyAck(ctx, pending[ack.GetId()], ack); err != nil {
+				return nil, 0, err
 			}
+			continue
 		}
+
+		pulled = append(pulled, message.GetSecret())
+		cursor = message.GetCursor()
 	}
-	recv, err := stream.CloseAndRecv()
-	if err != nil {
-		return err
+}
+
+// send pushes local changes followed by the pull request
+func (a *agent) send(stream pb.Sync_SyncClient, changes []*pb.SyncRequest, since uint64) error {
+	for _, change := range changes {
+		if err := stream.Send(change); err != nil {
+			return err
+		}
 	}
-	if recv.GetError() != "" {
-		return errors.New(recv.GetError())
+
+	if err := stream.Send(&pb.SyncRequest{Since: since}); err != nil {
+		return err
 	}
 
-	return nil
+	return stream.CloseSend()
 }
 
-func (a *agent) syncCreated(ctx context.Context, client pb.SyncClient) error {
-	secrets, err := a.secretsStorage.ListSecrets(ctx)
+// localChanges lists secrets changed locally since the last sync as sync requests,
+// secrets with unresolved conflicts are skipped
+func (a *agent) localChanges(ctx context.Context) ([]*pb.SyncRequest, error) {
+	secrets, err := a.secretsStorage.ListUnsyncedSecrets(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	stream, err := client.SyncCreated(ctx)
-	for _, secret := range secrets {
-		if !secret.Status.Deleted && !secret.Status.Synced && secret.UpdatedAt == nil {
-			err = stream.Send(&pb.SyncRequest{Secret: secret})
-			if err != nil {
-				return err
-			}
+	conflicts, err := a.conflictedSecrets(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-			secret.Status.Synced = true
+	changes := make([]*pb.SyncRequest, 0, len(secrets))
+	for _, secret := range secrets {
+		if _, ok := conflicts[secret.GetID()]; ok {
+			continue
+		}
 
-			if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
-				return err
+		var operation pb.SyncOperation
+		switch {
+		case secret.GetStatus().GetDeleted() && secret.GetRevision() == 0:
+			// The secret never reached the server
+			if err := a.secretsStorage.MarkSecretSynced(ctx, secret, 0); err != nil {
+				return nil, err
 			}
+			continue
+		case secret.GetStatus().GetDeleted():
+			operation = pb.SyncOperation_DELETE
+		case secret.GetRevision() == 0:
+			operation = pb.SyncOperation_CREATE
+		default:
+			operation = pb.SyncOperation_UPDATE
 		}
+
+		changes = append(changes, &pb.SyncRequest{
+			Secret:    secret,
+			Operation: operation,
+		})
+	}
+
+	return changes, nil
+}
+
+// applyAck marks the pushed secret synced with the revision assigned by the server,
+// the secret rejected as stale is kept unsynced with the server side recorded as a conflict.
+// The change rejected by the server is kept unsynced and pushed again by the next sync,
+// the secret missing on the server is pushed again as a new one
+func (a *agent) applyAck(ctx context.Context, secret *pb.Secret, ack *pb.SyncAck) error {
+	if secret == nil {
+		return fmt.Errorf("unexpected acknowledgement for secret %s", ack.GetId())
+	}
+
+	if ack.GetError() != "" {
+		log.Error().Msgf("Server rejected the change of secret %s: %s", ack.GetId(), ack.GetError())
+
+		return nil
+	}
+
+	if ack.GetConflict() != nil {
+		return a.saveConflict(ctx, ack.GetConflict())
+	}
+
+	if ack.GetNotFound() {
+		log.Info().Msgf("Secret %s is missing on the server, it's pushed again", ack.GetId())
+
+		return a.resetRevision(ctx, ack.GetId())
+	}
+
+	if ack.GetRevision() == 0 {
+		log.Error().Msgf("Acknowledgement of secret %s has no revision", ack.GetId())
+
+		return nil
+	}
+
+	return a.secretsStorage.MarkSecretSynced(ctx, secret, ack.GetRevision())
+}
+
+// resetRevision makes the secret unknown to the server, so the next sync creates it there
+// unless it's deleted
+func (a *agent) resetRevision(ctx context.Context, id string) error {
+	secret, err := a.secretsStorage.GetSecret(ctx, id)
+	if errors.Is(err, local.ErrNoSecretFound) {
+		return nil
 	}
-	recv, err := stream.CloseAndRecv()
 	if err != nil {
 		return err
 	}
-	if recv.GetError() != "" {
-		return errors.New(recv.GetError())
+
+	secret.Revision = 0
+	secret.Status.Synced = false
+	if err := a.secretsStorage.ReplaceSecret(ctx, secret); err != nil {
+		return err
 	}
 
+	a.requestSync()
+
 	return nil
 }
 
-func (a *agent) sync(ctx context.Context, client pb.SyncClient) error {
-	var secrets []*pb.Secret
-
-	stream, err := client.Sync(ctx, &pb.SyncRequest{})
+// saveConflict records the server side of the secret changed both locally and on the server.
+// The server side with the same content as the local one is taken without conflict, e.g. the secret
+// restored from the same export on both devices. Sides are compared only while the agent is unlocked
+func (a *agent) saveConflict(ctx context.Context, remote *pb.Secret) error {
+	localSecret, err := a.secretsStorage.GetSecret(ctx, remote.GetID())
 	if err != nil {
 		return err
 	}
 
-	for {
-		message, err := stream.Recv()
-		if errors.Is(err, io.EOF) {
-			break
+	if a.sameSides(localSecret, remote) {
+		remote.Status = &pb.Status{
+			Synced:  true,
+			Deleted: remote.GetStatus().GetDeleted(),
 		}
-		if err != nil {
+		if err := a.secretsStorage.ReplaceSecret(ctx, remote); err != nil {
 			return err
 		}
 
-		secrets = append(secrets, message.GetSecret())
+		return a.conflictsStorage.DeleteSecretConflict(ctx, remote.GetID())
+	}
+
+	log.Info().Msgf("Conflict detected for secret %s", remote.GetID())
+
+	return a.conflictsStorage.SaveSecretConflict(ctx, remote)
+}
+
+// sameSides reports whether local and server sides of the secret have the same status and content
+func (a *agent) sameSides(localSecret, remote *pb.Secret) bool {
+	if localSecret.GetStatus().GetDeleted() != remote.GetStatus().GetDeleted() {
+		return false
+	}
+
+	_, decrypt, err := a.crypto()
+	if err != nil {
+		return false
+	}
+
+	same, err := sameContent(localSecret, remote, decrypt)
+	if err != nil {
+		log.Error().Err(err).Msgf("Failed to compare sides of secret %s", remote.GetID())
+		return false
 	}
 
-	if err := stream.CloseSend(); err != nil {
+	return same
+}
+
+// conflictedSecrets returns remote revisions of secrets with unresolved conflicts by ID,
+// such secrets aren't pushed until resolved
+func (a *agent) conflictedSecrets(ctx context.Context) (map[string]uint64, error) {
+	conflicts, err := a.conflictsStorage.ListSecretConflicts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make(map[string]uint64, len(conflicts))
+	for _, conflict := range conflicts {
+		revisions[conflict.GetRemote().GetID()] = conflict.GetRemote().GetRevision()
+	}
+
+	return revisions, nil
+}
+
+// applyPulled applies secrets changed on the server and persists the cursor
+func (a *agent) applyPulled(ctx context.Context, secrets []*pb.Secret, since, cursor uint64) error {
+	conflicts, err := a.conflictedSecrets(ctx)
+	if err != nil {
 		return err
 	}
 
@@ -203,21 +421,43 @@ func (a *agent) sync(ctx context.Context, client pb.SyncClient) error {
 
 		secret.Status.Synced = true
 
+		conflictRevision, conflicted := conflicts[secret.GetID()]
+
 		switch {
-		case secret.GetStatus().GetDeleted():
-			if err := a.secretsStorage.DeleteSecret(ctx, secret); err != nil {
+		case errors.Is(err, local.ErrNoSecretFound):
+			if !secret.GetStatus().GetDeleted() {
+				if _, err := a.secretsStorage.CreateSecret(ctx, secret); err != nil {
+					return err
+				}
+			}
+		case secret.GetRevision() <= localSecret.GetRevision():
+			// The local secret is up to date or ahead of the server
+		case conflicted && secret.GetRevision() <= conflictRevision:
+			// The conflict is already recorded
+		case secret.GetStatus().GetDeleted() && localSecret.GetStatus().GetDeleted():
+			// Deleted on both sides
+			if err := a.updateSecret(ctx, secret); err != nil {
 				return err
 			}
-		case errors.Is(err, local.ErrNoSecretFound) && !secret.GetStatus().GetDeleted():
-			if _, err := a.secretsStorage.CreateSecret(ctx, secret); err != nil {
+			if err := a.conflictsStorage.DeleteSecretConflict(ctx, secret.GetID()); err != nil {
 				return err
 			}
-		case localSecret.GetUpdatedAt().AsTime().Before(secret.GetUpdatedAt().AsTime()) && !secret.GetStatus().GetDeleted():
-			if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
+		case conflicted || !localSecret.GetStatus().GetSynced():
+			// Both sides changed since the last sync
+			if err := a.saveConflict(ctx, secret); err != nil {
+				return err
+			}
+		default:
+			// Deleted secrets are moved to the trash, restored ones are moved out of it
+			if err := a.updateSecret(ctx, secret); err != nil {
 				return err
 			}
 		}
 	}
 
-	return nil
+	if cursor == since {
+		return nil
+	}
+
+	return a.accountsStorage.UpdateSyncCursor(ctx, cursor)
 }
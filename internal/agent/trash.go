@@ -0,0 +1,108 @@
+package agent
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+const purgeInterval = time.Hour
+
+var (
+	ErrSecretNotDeleted = status.Error(codes.FailedPrecondition, "secret is not deleted")
+)
+
+// UndeleteSecret moves the secret out of the trash, the change is synced as an update of the secret
+func (a *agent) UndeleteSecret(ctx context.Context, request *pb.UndeleteSecretRequest) (*pb.UndeleteSecretResponse, error) {
+	log.Info().Msgf("UndeleteSecret secret %s", request.GetId())
+
+	secret, err := a.secretsStorage.GetSecret(ctx, request.GetId())
+	if errors.Is(err, local.ErrNoSecretFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !secret.GetStatus().GetDeleted() {
+		return nil, ErrSecretNotDeleted
+	}
+
+	secret.DeletedAt = nil
+	secret.UpdatedAt = timestamppb.Now()
+	secret.Status.Deleted = false
+	secret.Status.Synced = false
+
+	if err := a.secretsStorage.UpdateSecret(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	return &pb.UndeleteSecretResponse{
+		Error: "",
+		Id:    secret.GetID(),
+	}, nil
+}
+
+func (a *agent) purgeWorker(ctx context.Context) {
+	if a.cfg.TrashRetention <= 0 {
+		return
+	}
+
+	purgeTicker := time.NewTicker(purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		if err := a.purge(ctx); err != nil {
+			log.Error().Err(err).Msg("a error occurred during purge deleted secrets")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-purgeTicker.C:
+		}
+	}
+}
+
+// purge permanently removes secrets deleted longer than TrashRetention ago.
+// While an account exists only secrets with the deletion synced are purged, so the server gets the tombstone
+func (a *agent) purge(ctx context.Context) error {
+	_, err := a.accountsStorage.GetAccount(ctx)
+	if err != nil && !errors.Is(err, local.ErrAccountNotExists) {
+		return err
+	}
+	syncRequired := err == nil
+
+	secrets, err := a.secretsStorage.ListSecrets(ctx)
+	if err != nil {
+		return err
+	}
+
+	purgeBefore := time.Now().Add(-a.cfg.TrashRetention)
+	for _, secret := range secrets {
+		if !secret.GetStatus().GetDeleted() || secret.GetDeletedAt() == nil {
+			continue
+		}
+		if syncRequired && !secret.GetStatus().GetSynced() {
+			continue
+		}
+		if !secret.GetDeletedAt().AsTime().Before(purgeBefore) {
+			continue
+		}
+
+		log.Info().Msgf("Purge secret %s", secret.GetID())
+
+		if err := a.secretsStorage.PurgeSecret(ctx, secret.GetID()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
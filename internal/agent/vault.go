@@ -0,0 +1,504 @@
+package agent
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+	"github.com/go-rfe/gpwd/internal/syncer"
+)
+
+const (
+	vaultFileName        = "vault.db"
+	vaultUpgradeFileName = "vault.db.upgrade"
+	legacyFileName       = "secrets.db"
+	legacyBackupFileName = "secrets.db.legacy"
+)
+
+var (
+	ErrInvalidMasterPassword = errors.New("invalid master password")
+	ErrInvalidRecoveryKey    = errors.New("invalid recovery key")
+	ErrNoRecoveryKey         = errors.New("vault has no recovery key")
+	ErrVaultNotExists        = errors.New("vault doesn't exist, start the agent first")
+	ErrInvalidVaultKey       = errors.New("invalid vault key of the account")
+
+	vaultVerifier = []byte("gpwd")
+)
+
+// openVault opens the agent store and unwraps the data encryption key with the master password.
+// A store created before key derivation was introduced is upgraded once.
+func (a *agent) openVault(ctx context.Context) (local.Storage, error) {
+	vaultPath := filepath.Join(a.cfg.StorePath, vaultFileName)
+	legacyPath := filepath.Join(a.cfg.StorePath, legacyFileName)
+
+	// The legacy store is SQLite only
+	_, err := os.Stat(vaultPath)
+	if errors.Is(err, os.ErrNotExist) && a.storeBackend() == local.SQLiteBackend {
+		if _, err := os.Stat(legacyPath); err == nil {
+			if err := a.upgradeLegacyVault(ctx, vaultPath, legacyPath); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	storage, err := local.Open(a.storeBackend(), vaultPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := a.unlockVault(ctx, storage); err != nil {
+		closeStorage(storage)
+		return nil, err
+	}
+
+	return storage, nil
+}
+
+func (a *agent) unlockVault(ctx context.Context, storage local.Vault) error {
+	vault, err := storage.GetVault(ctx)
+	if errors.Is(err, local.ErrNoVault) {
+		vault, err = a.createVault(ctx, storage)
+	}
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := openDataKey(vault, a.cfg.MasterPassword)
+	if err != nil {
+		return err
+	}
+
+	// Vaults created before envelope encryption sealed data with the derived key itself,
+	// which becomes the data encryption key from now on
+	if vault.WrappedKey == nil {
+		wrapped, err := sealVault(a.cfg.MasterPassword, dataKey, vault.KDF)
+		if err != nil {
+			return err
+		}
+
+		wrapped.RecoveryKey = vault.RecoveryKey
+		if err := storage.UpdateVault(ctx, wrapped); err != nil {
+			return err
+		}
+	}
+
+	return a.setDataKey(dataKey)
+}
+
+func (a *agent) createVault(ctx context.Context, storage local.Vault) (*local.VaultInfo, error) {
+	vault, _, err := a.newVault()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := storage.CreateVault(ctx, vault); err != nil {
+		return nil, err
+	}
+
+	return vault, nil
+}
+
+// newVault generates a data encryption key wrapped with the master password
+func (a *agent) newVault() (*local.VaultInfo, []byte, error) {
+	params, err := encryption.NewKDFParams(a.cfg.KDFTime, a.cfg.KDFMemory, a.cfg.KDFThreads)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dataKey, err := encryption.NewDataKey()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	vault, err := sealVault(a.cfg.MasterPassword, dataKey, params)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vault, dataKey, nil
+}
+
+// sealVault wraps the data encryption key with the key derived from the password
+func sealVault(password, dataKey []byte, params *encryption.KDFParams) (*local.VaultInfo, error) {
+	key, err := encryption.DeriveKey(password, params)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypt, _, err := encryption.GetCrypto(key)
+	if err != nil {
+		return nil, err
+	}
+
+	verifier, err := encrypt(vaultVerifier)
+	if err != nil {
+		return nil, err
+	}
+
+	wrappedKey, err := encryption.WrapKey(key, dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &local.VaultInfo{
+		KDF:        params,
+		Verifier:   verifier,
+		WrappedKey: wrappedKey,
+	}, nil
+}
+
+// openDataKey unwraps the data encryption key with the key derived from the password
+func openDataKey(vault *local.VaultInfo, password []byte) ([]byte, error) {
+	key, err := encryption.DeriveKey(password, vault.KDF)
+	if err != nil {
+		return nil, err
+	}
+
+	if vault.WrappedKey == nil {
+		_, decrypt, err := encryption.GetCrypto(key)
+		if err != nil {
+			return nil, err
+		}
+
+		verifier, err := decrypt(vault.Verifier)
+		if err != nil || !bytes.Equal(verifier, vaultVerifier) {
+			return nil, ErrInvalidMasterPassword
+		}
+
+		return key, nil
+	}
+
+	dataKey, err := encryption.UnwrapKey(key, vault.WrappedKey)
+	if err != nil {
+		return nil, ErrInvalidMasterPassword
+	}
+
+	return dataKey, nil
+}
+
+// ChangeMasterPassword rewraps the data encryption key with the new master password.
+// The current key is unwrapped with either the master password or the recovery key.
+// Secrets stay untouched, so a running agent keeps working.
+func (a *agent) ChangeMasterPassword(ctx context.Context, secret []byte, isRecoveryKey bool, newPassword []byte) error {
+	storage, err := a.openExistingVault()
+	if err != nil {
+		return err
+	}
+	defer closeStorage(storage)
+
+	vault, err := storage.GetVault(ctx)
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := openVaultDataKey(vault, secret, isRecoveryKey)
+	if err != nil {
+		return err
+	}
+
+	params, err := encryption.NewKDFParams(a.cfg.KDFTime, a.cfg.KDFMemory, a.cfg.KDFThreads)
+	if err != nil {
+		return err
+	}
+
+	rewrapped, err := sealVault(newPassword, dataKey, params)
+	if err != nil {
+		return err
+	}
+
+	rewrapped.RecoveryKey = vault.RecoveryKey
+
+	if err := a.publishVaultKey(ctx, storage, dataKey, rewrapped); err != nil {
+		return err
+	}
+
+	return storage.UpdateVault(ctx, rewrapped)
+}
+
+// CreateRecoveryKey wraps the data encryption key with a new random recovery key, replacing the previous one
+func (a *agent) CreateRecoveryKey(ctx context.Context, password []byte) (string, error) {
+	storage, err := a.openExistingVault()
+	if err != nil {
+		return "", err
+	}
+	defer closeStorage(storage)
+
+	vault, err := storage.GetVault(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	dataKey, err := openVaultDataKey(vault, password, false)
+	if err != nil {
+		return "", err
+	}
+
+	recoveryKey, printable, err := encryption.NewRecoveryKey()
+	if err != nil {
+		return "", err
+	}
+
+	vault.RecoveryKey, err = encryption.WrapKey(recoveryKey, dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	if err := a.publishVaultKey(ctx, storage, dataKey, vault); err != nil {
+		return "", err
+	}
+
+	if err := storage.UpdateVault(ctx, vault); err != nil {
+		return "", err
+	}
+
+	return printable, nil
+}
+
+// publishVaultKey replaces the vault key of the registered account on the server, so other devices of the account
+// unlock with the new master password or recovery key. The vault of the device without account is local only
+func (a *agent) publishVaultKey(ctx context.Context, storage local.Accounts, dataKey []byte, vault *local.VaultInfo) error {
+	account, err := storage.GetAccount(ctx)
+	if errors.Is(err, local.ErrAccountNotExists) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !account.GetRegistered() {
+		return nil
+	}
+
+	_, decrypt, err := encryption.GetCrypto(dataKey)
+	if err != nil {
+		return err
+	}
+
+	password, err := decrypt(account.GetUserPassword())
+	if err != nil {
+		return err
+	}
+
+	err = syncer.UpdateVaultKey(ctx, &pb.Account{
+		ServerAddress: account.GetServerAddress(),
+		UserName:      account.GetUserName(),
+		UserPassword:  password,
+	}, vaultKey(vault), a.serverDialOptions()...)
+	if err != nil {
+		return fmt.Errorf("couldn't update the vault key of account %s: %w", account.GetUserName(), err)
+	}
+
+	return nil
+}
+
+// adoptVaultKey re-encrypts the store with the data encryption key of the account registered from another device,
+// the key is unwrapped with the master password shared by devices of the account.
+// RPCs wait for the lock until all secrets are converted
+func (a *agent) adoptVaultKey(ctx context.Context, key *pb.VaultKey, masterPassword []byte) error {
+	shared, err := vaultInfo(key)
+	if err != nil {
+		return err
+	}
+
+	dataKey, err := openDataKey(shared, masterPassword)
+	if err != nil {
+		return fmt.Errorf("master password differs from the one of other devices of the account: %w", err)
+	}
+
+	encrypt, _, err := encryption.GetCrypto(dataKey)
+	if err != nil {
+		return err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	if a.dataKey == nil {
+		return ErrAgentLocked
+	}
+
+	decrypt := a.decrypt
+	err = a.vaultStorage.Rekey(ctx, shared, func(data []byte) ([]byte, error) {
+		plaintext, err := decrypt(data)
+		if err != nil {
+			return nil, err
+		}
+
+		return encrypt(plaintext)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info().Msg("Vault re-encrypted with the key of the account")
+
+	return a.replaceDataKey(dataKey)
+}
+
+// vaultKey returns the vault as the key shared by devices of the account
+func vaultKey(vault *local.VaultInfo) *pb.VaultKey {
+	return &pb.VaultKey{
+		Kdf:         vault.KDF.Algorithm,
+		Salt:        vault.KDF.Salt,
+		Time:        vault.KDF.Time,
+		Memory:      vault.KDF.Memory,
+		Threads:     uint32(vault.KDF.Threads),
+		Verifier:    vault.Verifier,
+		WrappedKey:  vault.WrappedKey,
+		RecoveryKey: vault.RecoveryKey,
+	}
+}
+
+// vaultInfo returns the vault of the key shared by devices of the account
+func vaultInfo(key *pb.VaultKey) (*local.VaultInfo, error) {
+	if key.GetThreads() == 0 || key.GetThreads() > math.MaxUint8 || len(key.GetWrappedKey()) == 0 {
+		return nil, ErrInvalidVaultKey
+	}
+
+	return &local.VaultInfo{
+		KDF: &encryption.KDFParams{
+			Algorithm: key.GetKdf(),
+			Salt:      key.GetSalt(),
+			Time:      key.GetTime(),
+			Memory:    key.GetMemory(),
+			Threads:   uint8(key.GetThreads()),
+		},
+		Verifier:    key.GetVerifier(),
+		WrappedKey:  key.GetWrappedKey(),
+		RecoveryKey: key.GetRecoveryKey(),
+	}, nil
+}
+
+func openVaultDataKey(vault *local.VaultInfo, secret []byte, isRecoveryKey bool) ([]byte, error) {
+	if !isRecoveryKey {
+		return openDataKey(vault, secret)
+	}
+
+	if vault.RecoveryKey == nil {
+		return nil, ErrNoRecoveryKey
+	}
+
+	recoveryKey, err := encryption.ParseRecoveryKey(string(secret))
+	if err != nil {
+		return nil, ErrInvalidRecoveryKey
+	}
+
+	dataKey, err := encryption.UnwrapKey(recoveryKey, vault.RecoveryKey)
+	if err != nil {
+		return nil, ErrInvalidRecoveryKey
+	}
+
+	return dataKey, nil
+}
+
+func (a *agent) openExistingVault() (local.Storage, error) {
+	vaultPath := filepath.Join(a.cfg.StorePath, vaultFileName)
+	if a.storeBackend() == local.SQLiteBackend {
+		if _, err := os.Stat(vaultPath); err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil, ErrVaultNotExists
+			}
+			return nil, err
+		}
+	}
+
+	storage, err := local.Open(a.storeBackend(), vaultPath)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't open vault: %w", err)
+	}
+
+	return storage, nil
+}
+
+// storeBackend returns the configured storage backend, SQLite is used by default
+func (a *agent) storeBackend() string {
+	if a.cfg.StoreBackend == "" {
+		return local.SQLiteBackend
+	}
+
+	return a.cfg.StoreBackend
+}
+
+func closeStorage(storage local.Storage) {
+	if err := storage.Close(); err != nil {
+		log.Error().Err(err).Msg("failed to close storage")
+	}
+}
+
+// upgradeLegacyVault re-encrypts a store sealed with the raw master password into a new vault.
+// The legacy store is kept as a backup next to the new one.
+func (a *agent) upgradeLegacyVault(ctx context.Context, vaultPath, legacyPath string) error {
+	log.Info().Msgf("Upgrading legacy store %s", legacyPath)
+
+	upgradePath := filepath.Join(a.cfg.StorePath, vaultUpgradeFileName)
+	if err := os.Remove(upgradePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+
+	if err := a.importLegacyVault(ctx, upgradePath, legacyPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(upgradePath, vaultPath); err != nil {
+		return err
+	}
+
+	backupPath := filepath.Join(a.cfg.StorePath, legacyBackupFileName)
+	if err := os.Rename(legacyPath, backupPath); err != nil {
+		return err
+	}
+
+	log.Info().Msgf("Legacy store upgraded, remove the backup %s once the new vault is verified", backupPath)
+
+	return nil
+}
+
+func (a *agent) importLegacyVault(ctx context.Context, upgradePath, legacyPath string) error {
+	_, legacyDecrypt, err := encryption.GetCrypto(a.cfg.MasterPassword)
+	if err != nil {
+		return errors.New("legacy store requires the master password of 16, 24 or 32 bytes")
+	}
+
+	storage, err := local.Open(local.SQLiteBackend, upgradePath)
+	if err != nil {
+		return err
+	}
+	defer closeStorage(storage)
+
+	importer, ok := storage.(local.LegacyImporter)
+	if !ok {
+		return fmt.Errorf("storage backend %s can't import the legacy store", local.SQLiteBackend)
+	}
+
+	vault, dataKey, err := a.newVault()
+	if err != nil {
+		return err
+	}
+
+	encrypt, _, err := encryption.GetCrypto(dataKey)
+	if err != nil {
+		return err
+	}
+
+	err = importer.ImportLegacy(ctx, legacyPath, a.cfg.MasterPassword, func(data []byte) ([]byte, error) {
+		plaintext, err := legacyDecrypt(data)
+		if err != nil {
+			return nil, ErrInvalidMasterPassword
+		}
+
+		return encrypt(plaintext)
+	})
+	if err != nil {
+		return err
+	}
+
+	return storage.CreateVault(ctx, vault)
+}
@@ -0,0 +1,208 @@
+//go:build cgo
+
+package agent
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+	_ "github.com/go-rfe/gpwd/internal/storage/local/sqlite"
+)
+
+// legacyMasterPassword sealed secrets of the legacy store as the raw AES key
+const legacyMasterPassword = "0123456789abcdef0123456789abcdef"
+
+// newSQLiteAgent returns the unlocked agent with the SQLite vault in the directory
+func newSQLiteAgent(t *testing.T, storePath, masterPassword string) *agent {
+	t.Helper()
+
+	a := NewAgent(&Cfg{
+		StorePath:      storePath,
+		StoreBackend:   local.SQLiteBackend,
+		MasterPassword: []byte(masterPassword),
+		KDFTime:        1,
+		KDFMemory:      1024,
+		KDFThreads:     1,
+	})
+
+	storage, err := a.openVault(context.Background())
+	if err != nil {
+		t.Fatalf("openVault() error = %v", err)
+	}
+	t.Cleanup(func() {
+		closeStorage(storage)
+	})
+
+	a.setStorage(storage)
+
+	return a
+}
+
+// writeLegacyStore creates the store of agents before the vault, secrets are sealed with the master password
+func writeLegacyStore(t *testing.T, path string, secrets map[string]string) {
+	t.Helper()
+
+	db, err := sql.Open("sqlite3", path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	_, err = db.Exec(`
+		CREATE TABLE secrets (
+			id VARCHAR PRIMARY KEY, labels TEXT, data BLOB,
+			created_at TEXT, updated_at TEXT DEFAULT NULL, deleted_at TEXT DEFAULT NULL,
+			synced BOOLEAN DEFAULT false NOT NULL, deleted BOOLEAN DEFAULT false NOT NULL
+		);
+		CREATE TABLE accounts (
+			id VARCHAR PRIMARY KEY, server TEXT NOT NULL UNIQUE, username TEXT NOT NULL,
+			password BLOB NOT NULL, registered BOOLEAN DEFAULT false NOT NULL
+		);
+	`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	encrypt, _, err := encryption.GetCrypto([]byte(legacyMasterPassword))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for id, data := range secrets {
+		sealed, err := encrypt([]byte(data))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = db.Exec(`INSERT INTO secrets (id, labels, data, created_at) VALUES (?, '{}', ?, ?);`,
+			id, sealed, timestamppb.Now().AsTime().String())
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestUpgradeLegacyVault(t *testing.T) {
+	storePath := t.TempDir()
+	writeLegacyStore(t, filepath.Join(storePath, legacyFileName), map[string]string{"legacy": "legacy data"})
+
+	a := newSQLiteAgent(t, storePath, legacyMasterPassword)
+
+	if got := string(getSecret(t, a, "legacy").GetData()); got != "legacy data" {
+		t.Errorf("GetSecret() data = %q, want %q", got, "legacy data")
+	}
+
+	for _, name := range []string{vaultFileName, legacyBackupFileName} {
+		if _, err := os.Stat(filepath.Join(storePath, name)); err != nil {
+			t.Errorf("%s after upgrade: %v", name, err)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(storePath, legacyFileName)); !os.IsNotExist(err) {
+		t.Errorf("legacy store is kept after upgrade: %v", err)
+	}
+
+	// Secrets are sealed with the new data encryption key, not with the master password
+	stored, err := a.secretsStorage.GetSecret(context.Background(), "legacy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, legacyDecrypt, err := encryption.GetCrypto([]byte(legacyMasterPassword))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := legacyDecrypt(stored.GetData()); err == nil {
+		t.Error("upgraded secret is still sealed with the master password")
+	}
+}
+
+func TestChangeMasterPassword(t *testing.T) {
+	ctx := context.Background()
+	storePath := t.TempDir()
+
+	a := newSQLiteAgent(t, storePath, testMasterPassword)
+	id := createSecret(t, a, &pb.Secret{Data: []byte("data")})
+
+	sealed, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := a.ChangeMasterPassword(ctx, []byte("wrong"), false, []byte("new password")); !errors.Is(err, ErrInvalidMasterPassword) {
+		t.Errorf("ChangeMasterPassword() with wrong password error = %v, want %v", err, ErrInvalidMasterPassword)
+	}
+
+	if err := a.ChangeMasterPassword(ctx, []byte(testMasterPassword), false, []byte("new password")); err != nil {
+		t.Fatalf("ChangeMasterPassword() error = %v", err)
+	}
+
+	// The data encryption key is rewrapped, secrets stay sealed with it
+	stored, err := a.secretsStorage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stored.GetData(), sealed.GetData()) {
+		t.Error("secret is re-encrypted on master password change")
+	}
+
+	vault, err := a.vaultStorage.GetVault(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := openDataKey(vault, []byte(testMasterPassword)); !errors.Is(err, ErrInvalidMasterPassword) {
+		t.Errorf("openDataKey() with the old password error = %v, want %v", err, ErrInvalidMasterPassword)
+	}
+
+	reopened := newSQLiteAgent(t, storePath, "new password")
+	if got := string(getSecret(t, reopened, id).GetData()); got != "data" {
+		t.Errorf("GetSecret() with the new password data = %q, want %q", got, "data")
+	}
+}
+
+func TestRecoveryKey(t *testing.T) {
+	ctx := context.Background()
+	storePath := t.TempDir()
+
+	a := newSQLiteAgent(t, storePath, testMasterPassword)
+	id := createSecret(t, a, &pb.Secret{Data: []byte("data")})
+
+	if err := a.ChangeMasterPassword(ctx, []byte("recovery"), true, []byte("new password")); !errors.Is(err, ErrNoRecoveryKey) {
+		t.Errorf("ChangeMasterPassword() without recovery key error = %v, want %v", err, ErrNoRecoveryKey)
+	}
+
+	recoveryKey, err := a.CreateRecoveryKey(ctx, []byte(testMasterPassword))
+	if err != nil {
+		t.Fatalf("CreateRecoveryKey() error = %v", err)
+	}
+
+	_, otherKey, err := encryption.NewRecoveryKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := a.ChangeMasterPassword(ctx, []byte(otherKey), true, []byte("new password")); !errors.Is(err, ErrInvalidRecoveryKey) {
+		t.Errorf("ChangeMasterPassword() with wrong recovery key error = %v, want %v", err, ErrInvalidRecoveryKey)
+	}
+
+	// The forgotten master password is replaced with the recovery key
+	if err := a.ChangeMasterPassword(ctx, []byte(recoveryKey), true, []byte("new password")); err != nil {
+		t.Fatalf("ChangeMasterPassword() with recovery key error = %v", err)
+	}
+
+	reopened := newSQLiteAgent(t, storePath, "new password")
+	if got := string(getSecret(t, reopened, id).GetData()); got != "data" {
+		t.Errorf("GetSecret() with the new password data = %q, want %q", got, "data")
+	}
+}
@@ -0,0 +1,67 @@
+package agent
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+var (
+	ErrSecretDeleted = status.Error(codes.NotFound, "secret is deleted, undelete it first")
+)
+
+// ListSecretVersions returns prior revisions of the secret, the latest first
+func (a *agent) ListSecretVersions(ctx context.Context, request *pb.ListSecretVersionsRequest) (*pb.ListSecretVersionsResponse, error) {
+	versions, err := a.versionsStorage.ListSecretVersions(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListSecretVersionsResponse{
+		Error:    "",
+		Versions: versions,
+	}, nil
+}
+
+// RestoreSecretVersion makes the prior revision current, the current one is archived as a new version
+func (a *agent) RestoreSecretVersion(ctx context.Context, request *pb.RestoreSecretVersionRequest) (*pb.RestoreSecretVersionResponse, error) {
+	log.Info().Msgf("RestoreSecretVersion secret %s version %d", request.GetId(), request.GetVersion())
+
+	secret, err := a.secretsStorage.GetSecret(ctx, request.GetId())
+	if err != nil {
+		return nil, err
+	}
+	if secret.GetStatus().GetDeleted() {
+		return nil, ErrSecretDeleted
+	}
+
+	version, err := a.versionsStorage.GetSecretVersion(ctx, request.GetId(), request.GetVersion())
+	if errors.Is(err, local.ErrNoSecretVersionFound) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	secret.Labels = version.GetSecret().GetLabels()
+	secret.Data = version.GetSecret().GetData()
+	secret.Payload = version.GetSecret().GetPayload()
+	secret.UpdatedAt = timestamppb.Now()
+	secret.Status.Synced = false
+
+	if err := a.updateSecret(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	return &pb.RestoreSecretVersionResponse{
+		Error: "",
+		Id:    secret.GetID(),
+	}, nil
+}
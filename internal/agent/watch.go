@@ -0,0 +1,123 @@
+package agent
+
+import (
+	"context"
+	"math/rand"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const (
+	watchMinBackoff = time.Second
+	watchMaxBackoff = 5 * time.Minute
+)
+
+// mutatingMethods change local secrets, so they are pushed right away
+var mutatingMethods = map[string]struct{}{
+	"/proto.Secrets/CreateSecret":          {},
+	"/proto.Secrets/CreateSecrets":         {},
+	"/proto.Secrets/UpdateSecret":          {},
+	"/proto.Secrets/DeleteSecret":          {},
+	"/proto.Secrets/UndeleteSecret":        {},
+	"/proto.Secrets/RestoreSecretVersion":  {},
+	"/proto.Secrets/ResolveSecretConflict": {},
+	"/proto.Secrets/RestoreSecrets":        {},
+	"/proto.Secrets/GetSecretOTP":          {}, // HOTP counter
+	"/proto.Accounts/CreateAccount":        {},
+}
+
+// watchWorker holds the Watch stream open and requests sync on server events.
+// While disconnected syncWorker polls the server, reconnection uses exponential backoff with jitter
+func (a *agent) watchWorker(ctx context.Context) {
+	random := rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	for attempt := 0; ; attempt++ {
+		connected, err := a.watch(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			attempt = 0
+		}
+		log.Debug().Err(err).Msg("server watch is disconnected")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff(attempt, random)):
+		}
+	}
+}
+
+// watch returns whether the stream was established before it failed
+func (a *agent) watch(ctx context.Context) (bool, error) {
+	watchCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	client, err := a.getSyncClient(watchCtx)
+	if err != nil {
+		return false, err
+	}
+
+	stream, err := client.Watch(watchCtx, &pb.WatchRequest{})
+	if err != nil {
+		return false, err
+	}
+
+	// The first event without ID confirms the subscription
+	if _, err := stream.Recv(); err != nil {
+		return false, err
+	}
+
+	atomic.StoreInt32(&a.watching, 1)
+	defer atomic.StoreInt32(&a.watching, 0)
+
+	log.Debug().Msg("server watch is connected")
+
+	// Changes made while disconnected
+	a.requestSync()
+
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			return true, err
+		}
+
+		log.Debug().Msgf("server change of secret %s", event.GetId())
+		a.requestSync()
+	}
+}
+
+func (a *agent) isWatching() bool {
+	return atomic.LoadInt32(&a.watching) == 1
+}
+
+// backoff returns the delay before the next attempt: exponential, capped and with "equal jitter"
+func backoff(attempt int, random *rand.Rand) time.Duration {
+	delay := watchMaxBackoff
+	if attempt < 32 && watchMinBackoff<<attempt < watchMaxBackoff {
+		delay = watchMinBackoff << attempt
+	}
+
+	return delay/2 + time.Duration(random.Int63n(int64(delay/2)+1))
+}
+
+// syncUnaryInterceptor requests sync after local secrets are changed
+func (a *agent) syncUnaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if _, ok := mutatingMethods[info.FullMethod]; ok && err == nil {
+		a.requestSync()
+	}
+
+	return resp, err
+}
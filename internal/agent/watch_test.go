@@ -0,0 +1,30 @@
+package agent
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	random := rand.New(rand.NewSource(1))
+
+	// Delays grow from the minimum and stay at the cap, including attempts which would overflow the shift
+	for _, attempt := range []int{0, 1, 2, 8, 9, 31, 32, 63, 64, 1000} {
+		delay := watchMaxBackoff
+		if attempt < 9 {
+			delay = watchMinBackoff << attempt
+		}
+
+		for i := 0; i < 100; i++ {
+			got := backoff(attempt, random)
+			if got < delay/2 || got > delay {
+				t.Fatalf("backoff(%d) = %v, want between %v and %v", attempt, got, delay/2, delay)
+			}
+		}
+	}
+
+	if got := backoff(1000, random); got > 5*time.Minute {
+		t.Errorf("backoff(1000) = %v, want at most %v", got, 5*time.Minute)
+	}
+}
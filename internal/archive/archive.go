@@ -0,0 +1,271 @@
+// Package archive reads and writes vault exports.
+// The archive is the gzipped Archive protobuf encrypted with AES-256-GCM by the key derived from the passphrase
+// with Argon2id. The format version and KDF parameters are kept in the plaintext header authenticated by GCM,
+// so any change of the archive fails the integrity check
+package archive
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const (
+	// Extension is the file extension of encrypted archives
+	Extension = ".gpwdx"
+	// Version is the archive format version written
+	Version byte = 1
+
+	magic = "GPWDX"
+
+	maxHeaderLength = 64 * 1024
+
+	// The header isn't authenticated until the key is derived, so the cost of derivation is bounded
+	maxKDFTime    uint32 = 64
+	maxKDFMemory  uint32 = 4 * 1024 * 1024 // KiB
+	maxKDFThreads uint8  = 64
+	minSaltLength        = 16
+	maxSaltLength        = 64
+)
+
+var (
+	ErrNotArchive         = errors.New("not a gpwd archive")
+	ErrUnsupportedVersion = errors.New("unsupported archive version")
+	ErrIntegrity          = errors.New("archive integrity check failed, the passphrase is wrong or the archive is corrupted")
+	ErrEmptyPassphrase    = errors.New("archive passphrase can't be empty")
+)
+
+// header is the plaintext part of the archive describing how to derive the key
+type header struct {
+	KDF   kdfHeader `json:"kdf"`
+	Nonce []byte    `json:"nonce"`
+}
+
+type kdfHeader struct {
+	Algorithm string `json:"algorithm"`
+	Salt      []byte `json:"salt"`
+	Time      uint32 `json:"time"`
+	Memory    uint32 `json:"memory"`
+	Threads   uint8  `json:"threads"`
+}
+
+// Write encrypts the archive with the key derived from the passphrase with the KDF parameters
+func Write(w io.Writer, archive *pb.Archive, passphrase []byte, kdf *encryption.KDFParams) error {
+	if len(passphrase) == 0 {
+		return ErrEmptyPassphrase
+	}
+
+	if !validKDF(kdf) {
+		return fmt.Errorf("archive KDF parameters are out of bounds: time up to %d, memory up to %d KiB, "+
+			"threads up to %d, salt of %d to %d bytes", maxKDFTime, maxKDFMemory, maxKDFThreads, minSaltLength, maxSaltLength)
+	}
+
+	key, err := encryption.DeriveKey(passphrase, kdf)
+	if err != nil {
+		return err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+
+	prefix, err := marshalHeader(&header{
+		KDF: kdfHeader{
+			Algorithm: kdf.Algorithm,
+			Salt:      kdf.Salt,
+			Time:      kdf.Time,
+			Memory:    kdf.Memory,
+			Threads:   kdf.Threads,
+		},
+		Nonce: nonce,
+	})
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := compress(archive)
+	if err != nil {
+		return err
+	}
+
+	if _, err := w.Write(prefix); err != nil {
+		return err
+	}
+
+	_, err = w.Write(gcm.Seal(nil, nonce, plaintext, prefix))
+
+	return err
+}
+
+// Read verifies and decrypts the archive with the passphrase
+func Read(r io.Reader, passphrase []byte) (*pb.Archive, error) {
+	if len(passphrase) == 0 {
+		return nil, ErrEmptyPassphrase
+	}
+
+	prefix, h, err := readHeader(r)
+	if err != nil {
+		return nil, err
+	}
+
+	kdf := &encryption.KDFParams{
+		Algorithm: h.KDF.Algorithm,
+		Salt:      h.KDF.Salt,
+		Time:      h.KDF.Time,
+		Memory:    h.KDF.Memory,
+		Threads:   h.KDF.Threads,
+	}
+	if !validKDF(kdf) {
+		return nil, ErrIntegrity
+	}
+
+	key, err := encryption.DeriveKey(passphrase, kdf)
+	if err != nil {
+		return nil, err
+	}
+
+	gcm, err := newGCM(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(h.Nonce) != gcm.NonceSize() {
+		return nil, ErrIntegrity
+	}
+
+	ciphertext, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := gcm.Open(nil, h.Nonce, ciphertext, prefix)
+	if err != nil {
+		return nil, ErrIntegrity
+	}
+
+	return decompress(plaintext)
+}
+
+// validKDF reports whether the KDF parameters are within bounds of archives
+func validKDF(kdf *encryption.KDFParams) bool {
+	return kdf.Algorithm == encryption.KDFArgon2id &&
+		kdf.Time > 0 && kdf.Time <= maxKDFTime &&
+		kdf.Memory > 0 && kdf.Memory <= maxKDFMemory &&
+		kdf.Threads > 0 && kdf.Threads <= maxKDFThreads &&
+		len(kdf.Salt) >= minSaltLength && len(kdf.Salt) <= maxSaltLength
+}
+
+// marshalHeader returns the magic, the version and the length prefixed JSON header
+func marshalHeader(h *header) ([]byte, error) {
+	encoded, err := json.Marshal(h)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := make([]byte, len(magic)+1+4, len(magic)+1+4+len(encoded))
+	copy(prefix, magic)
+	prefix[len(magic)] = Version
+	binary.BigEndian.PutUint32(prefix[len(magic)+1:], uint32(len(encoded)))
+
+	return append(prefix, encoded...), nil
+}
+
+// readHeader returns the raw header to authenticate along with the parsed one
+func readHeader(r io.Reader) ([]byte, *header, error) {
+	fixed := make([]byte, len(magic)+1+4)
+	if _, err := io.ReadFull(r, fixed); err != nil {
+		if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return nil, nil, ErrNotArchive
+		}
+		return nil, nil, err
+	}
+
+	if string(fixed[:len(magic)]) != magic {
+		return nil, nil, ErrNotArchive
+	}
+
+	if version := fixed[len(magic)]; version != Version {
+		return nil, nil, fmt.Errorf("%w %d", ErrUnsupportedVersion, version)
+	}
+
+	length := binary.BigEndian.Uint32(fixed[len(magic)+1:])
+	if length > maxHeaderLength {
+		return nil, nil, ErrIntegrity
+	}
+
+	encoded := make([]byte, length)
+	if _, err := io.ReadFull(r, encoded); err != nil {
+		return nil, nil, ErrIntegrity
+	}
+
+	h := &header{}
+	if err := json.Unmarshal(encoded, h); err != nil {
+		return nil, nil, ErrIntegrity
+	}
+
+	return append(fixed, encoded...), h, nil
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+func compress(archive *pb.Archive) ([]byte, error) {
+	data, err := proto.Marshal(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(data); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func decompress(plaintext []byte) (*pb.Archive, error) {
+	zr, err := gzip.NewReader(bytes.NewReader(plaintext))
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		return nil, err
+	}
+
+	archive := &pb.Archive{}
+	if err := proto.Unmarshal(data, archive); err != nil {
+		return nil, err
+	}
+
+	return archive, nil
+}
@@ -0,0 +1,221 @@
+package archive
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var passphrase = []byte("correct horse battery staple")
+
+func testArchive() *pb.Archive {
+	createdAt := timestamppb.New(time.Unix(1600000000, 0))
+
+	return &pb.Archive{
+		CreatedAt: timestamppb.New(time.Unix(1700000000, 0)),
+		Secrets: []*pb.ExportedSecret{
+			{
+				Secret: &pb.Secret{
+					ID:        "login",
+					Labels:    map[string]string{"site": "example.com"},
+					CreatedAt: createdAt,
+					UpdatedAt: timestamppb.New(time.Unix(1600000100, 0)),
+					Data:      []byte("notes"),
+					Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+						Username: "alice",
+						Password: []byte("new password"),
+						URIs:     []string{"https://example.com"},
+					}},
+				},
+				Versions: []*pb.SecretVersion{{
+					Version:    1,
+					ArchivedAt: timestamppb.New(time.Unix(1600000100, 0)),
+					Secret: &pb.Secret{
+						ID:        "login",
+						CreatedAt: createdAt,
+						Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+							Username: "alice",
+							Password: []byte("old password"),
+						}},
+					},
+				}},
+			},
+			{
+				Secret: &pb.Secret{
+					ID:        "note",
+					CreatedAt: createdAt,
+					DeletedAt: timestamppb.New(time.Unix(1600000200, 0)),
+					Status:    &pb.Status{Deleted: true},
+					Payload:   &pb.Secret_Note{Note: &pb.NotePayload{Text: []byte("line 1\nline 2")}},
+				},
+			},
+		},
+	}
+}
+
+func writeArchive(t *testing.T) []byte {
+	t.Helper()
+
+	kdf, err := encryption.NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	if err := Write(&buf, testArchive(), passphrase, kdf); err != nil {
+		t.Fatalf("Write() error = %v", err)
+	}
+
+	return buf.Bytes()
+}
+
+func TestReadWrite(t *testing.T) {
+	data := writeArchive(t)
+
+	if bytes.Contains(data, []byte("password")) || bytes.Contains(data, []byte("alice")) {
+		t.Fatal("archive contains plaintext")
+	}
+
+	got, err := Read(bytes.NewReader(data), passphrase)
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if want := testArchive(); !proto.Equal(want, got) {
+		t.Errorf("Read() = %v, want %v", got, want)
+	}
+
+	if _, err := Read(bytes.NewReader(data), []byte("wrong")); !errors.Is(err, ErrIntegrity) {
+		t.Errorf("Read() with wrong passphrase error = %v, want %v", err, ErrIntegrity)
+	}
+
+	if err := Write(new(bytes.Buffer), testArchive(), nil, nil); !errors.Is(err, ErrEmptyPassphrase) {
+		t.Errorf("Write() with empty passphrase error = %v, want %v", err, ErrEmptyPassphrase)
+	}
+}
+
+func TestReadTampered(t *testing.T) {
+	data := writeArchive(t)
+
+	// Every byte of the header and the ciphertext is authenticated
+	for _, i := range []int{len(magic) + 1 + 4 + 10, len(data) / 2, len(data) - 1} {
+		tampered := append([]byte(nil), data...)
+		tampered[i] ^= 0x01
+
+		if _, err := Read(bytes.NewReader(tampered), passphrase); err == nil {
+			t.Errorf("Read() of archive changed at %d succeeded", i)
+		}
+	}
+
+	if _, err := Read(bytes.NewReader(data[:len(data)-16]), passphrase); !errors.Is(err, ErrIntegrity) {
+		t.Errorf("Read() of truncated archive error = %v, want %v", err, ErrIntegrity)
+	}
+
+	if _, err := Read(bytes.NewReader([]byte("{\"secrets\": []}")), passphrase); !errors.Is(err, ErrNotArchive) {
+		t.Errorf("Read() of JSON error = %v, want %v", err, ErrNotArchive)
+	}
+
+	future := append([]byte(nil), data...)
+	future[len(magic)] = Version + 1
+	if _, err := Read(bytes.NewReader(future), passphrase); !errors.Is(err, ErrUnsupportedVersion) {
+		t.Errorf("Read() of future version error = %v, want %v", err, ErrUnsupportedVersion)
+	}
+}
+
+func TestReadKDFBounds(t *testing.T) {
+	kdf, err := encryption.NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		change func(h *kdfHeader)
+	}{
+		{"unknown algorithm", func(h *kdfHeader) { h.Algorithm = "scrypt" }},
+		{"zero time", func(h *kdfHeader) { h.Time = 0 }},
+		{"huge time", func(h *kdfHeader) { h.Time = maxKDFTime + 1 }},
+		{"zero memory", func(h *kdfHeader) { h.Memory = 0 }},
+		{"huge memory", func(h *kdfHeader) { h.Memory = maxKDFMemory + 1 }},
+		{"zero threads", func(h *kdfHeader) { h.Threads = 0 }},
+		{"many threads", func(h *kdfHeader) { h.Threads = maxKDFThreads + 1 }},
+		{"short salt", func(h *kdfHeader) { h.Salt = h.Salt[:minSaltLength-1] }},
+		{"long salt", func(h *kdfHeader) { h.Salt = make([]byte, maxSaltLength+1) }},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			h := &header{
+				KDF: kdfHeader{
+					Algorithm: kdf.Algorithm,
+					Salt:      kdf.Salt,
+					Time:      kdf.Time,
+					Memory:    kdf.Memory,
+					Threads:   kdf.Threads,
+				},
+				Nonce: make([]byte, 12),
+			}
+			tt.change(&h.KDF)
+
+			prefix, err := marshalHeader(h)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			data := append(prefix, make([]byte, 32)...)
+			if _, err := Read(bytes.NewReader(data), passphrase); !errors.Is(err, ErrIntegrity) {
+				t.Errorf("Read() error = %v, want %v", err, ErrIntegrity)
+			}
+		})
+	}
+
+	huge, err := encryption.NewKDFParams(maxKDFTime+1, 1024, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Write(&bytes.Buffer{}, testArchive(), passphrase, huge); err == nil {
+		t.Error("Write() with out of bounds KDF parameters succeeded")
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, testArchive()); err != nil {
+		t.Fatalf("WriteCSV() error = %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("WriteCSV() output isn't CSV: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("WriteCSV() = %d records, want header and 2 secrets", len(records))
+	}
+
+	row := make(map[string]string)
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+
+	for column, want := range map[string]string{
+		"id":         "login",
+		"type":       "login",
+		"labels":     `{"site":"example.com"}`,
+		"created_at": "2020-09-13T12:26:40Z",
+		"data":       "notes",
+		"username":   "alice",
+		"password":   "new password",
+	} {
+		if row[column] != want {
+			t.Errorf("WriteCSV() %s = %q, want %q", column, row[column], want)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package archive
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const (
+	FormatArchive = "gpwdx"
+	FormatJSON    = "json"
+	FormatCSV     = "csv"
+)
+
+// csvHeader lists CSV columns, columns of other secret types are left empty
+var csvHeader = []string{
+	"id", "type", "labels", "created_at", "updated_at", "deleted_at", "data",
+	"username", "password", "uris", "totp",
+	"card_holder", "card_number", "card_expiry", "card_cvv",
+	"text",
+	"ssh_public_key", "ssh_private_key",
+	"file_name", "file_mime_type", "file_content",
+}
+
+// WriteJSON writes the archive as plaintext JSON with prior versions, bytes fields are base64 encoded
+func WriteJSON(w io.Writer, archive *pb.Archive) error {
+	data, err := protojson.MarshalOptions{Multiline: true, Indent: "  "}.Marshal(archive)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(append(data, '\n'))
+
+	return err
+}
+
+// WriteCSV writes current secrets of the archive as plaintext CSV one secret per row, prior versions are omitted.
+// Binary SSH private keys and file contents are base64 encoded
+func WriteCSV(w io.Writer, archive *pb.Archive) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(csvHeader); err != nil {
+		return err
+	}
+
+	for _, exported := range archive.GetSecrets() {
+		row, err := csvRow(exported.GetSecret())
+		if err != nil {
+			return err
+		}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+	}
+
+	cw.Flush()
+
+	return cw.Error()
+}
+
+func csvRow(secret *pb.Secret) ([]string, error) {
+	var labels []byte
+	if len(secret.GetLabels()) > 0 {
+		var err error
+		labels, err = json.Marshal(secret.GetLabels())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	row := make(map[string]string, len(csvHeader))
+	row["id"] = secret.GetID()
+	row["type"] = payload.Type(secret)
+	row["labels"] = string(labels)
+	row["created_at"] = formatTime(secret.GetCreatedAt())
+	row["updated_at"] = formatTime(secret.GetUpdatedAt())
+	row["deleted_at"] = formatTime(secret.GetDeletedAt())
+	row["data"] = string(secret.GetData())
+
+	if login := secret.GetLogin(); login != nil {
+		row["username"] = login.GetUsername()
+		row["password"] = string(login.GetPassword())
+		row["uris"] = strings.Join(login.GetURIs(), " ")
+		row["totp"] = string(login.GetTOTPSeed())
+	}
+
+	if card := secret.GetCard(); card != nil {
+		row["card_holder"] = card.GetHolder()
+		row["card_number"] = string(card.GetNumber())
+		row["card_expiry"] = card.GetExpiry()
+		row["card_cvv"] = string(card.GetCVV())
+	}
+
+	if note := secret.GetNote(); note != nil {
+		row["text"] = string(note.GetText())
+	}
+
+	if sshKey := secret.GetSshKey(); sshKey != nil {
+		row["ssh_public_key"] = strings.TrimSpace(string(sshKey.GetPublicKey()))
+		row["ssh_private_key"] = encryption.ToBase64(sshKey.GetPrivateKey())
+	}
+
+	if file := secret.GetFile(); file != nil {
+		row["file_name"] = file.GetName()
+		row["file_mime_type"] = file.GetMIMEType()
+		row["file_content"] = encryption.ToBase64(file.GetContent())
+	}
+
+	values := make([]string, 0, len(csvHeader))
+	for _, column := range csvHeader {
+		values = append(values, row[column])
+	}
+
+	return values, nil
+}
+
+func formatTime(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.AsTime().Format(time.RFC3339Nano)
+}
@@ -6,7 +6,9 @@ import (
 	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
 )
 
-func (c *client) Create(server string, username string, password []byte) (string, error) {
+// Create creates the account registered on the server, the master password of the agent unwraps the vault key
+// of the account registered from another device
+func (c *client) Create(server string, username string, password, masterPassword []byte) (string, error) {
 	account := pb.Account{
 		ServerAddress: server,
 		UserName:      username,
@@ -14,7 +16,8 @@ func (c *client) Create(server string, username string, password []byte) (string
 	}
 
 	createAccountRequest := pb.CreateAccountRequest{
-		Account: &account,
+		Account:        &account,
+		MasterPassword: masterPassword,
 	}
 	resp, err := c.grpc.CreateAccount(c.ctx, &createAccountRequest)
 	if err != nil {
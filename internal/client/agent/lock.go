@@ -0,0 +1,19 @@
+package agent
+
+import (
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+func (c *client) Lock() error {
+	resp, err := c.grpc.Lock(c.ctx, &pb.LockRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetError() != "" {
+		return errors.New(resp.GetError())
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package agent
+
+import (
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+func (c *client) SyncStatus() (*pb.SyncStatusResponse, error) {
+	resp, err := c.grpc.SyncStatus(c.ctx, &pb.SyncStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetError() != "" {
+		return nil, errors.New(resp.GetError())
+	}
+
+	return resp, nil
+}
+
+func (c *client) SyncNow() error {
+	resp, err := c.grpc.SyncNow(c.ctx, &pb.SyncNowRequest{})
+	if err != nil {
+		return err
+	}
+	if resp.GetError() != "" {
+		return errors.New(resp.GetError())
+	}
+
+	return nil
+}
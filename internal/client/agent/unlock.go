@@ -0,0 +1,19 @@
+package agent
+
+import (
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+func (c *client) Unlock(password []byte) error {
+	resp, err := c.grpc.Unlock(c.ctx, &pb.UnlockRequest{Password: password})
+	if err != nil {
+		return err
+	}
+	if resp.GetError() != "" {
+		return errors.New(resp.GetError())
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package secrets
+
+import (
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+func (c *client) Conflicts() ([]*pb.SecretConflict, error) {
+	resp, err := c.grpc.ListSecretConflicts(c.ctx, &pb.ListSecretConflictsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetError() != "" {
+		return nil, errors.New(resp.GetError())
+	}
+
+	return resp.GetConflicts(), nil
+}
+
+// ResolveConflict returns ID of the secret and ID of the local copy created to keep both sides
+func (c *client) ResolveConflict(id string, resolution pb.Resolution) (string, string, error) {
+	resp, err := c.grpc.ResolveSecretConflict(c.ctx, &pb.ResolveSecretConflictRequest{
+		Id:         id,
+		Resolution: resolution,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	if resp.GetError() != "" {
+		return "", "", errors.New(resp.GetError())
+	}
+
+	return resp.GetId(), resp.GetCopyId(), nil
+}
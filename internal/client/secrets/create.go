@@ -9,22 +9,24 @@ import (
 )
 
 func (c *client) Create(data []byte, labels []string) (string, error) {
+	return c.CreateSecret(&pb.Secret{Data: data}, labels)
+}
+
+// CreateSecret creates the secret with a typed payload
+func (c *client) CreateSecret(secret *pb.Secret, labels []string) (string, error) {
 	labelsMap, err := constructLabels(labels)
 	if err != nil {
 		return "", err
 	}
 
-	secret := pb.Secret{
-		Data:      data,
-		Labels:    labelsMap,
-		CreatedAt: timestamppb.Now(),
-		Status: &pb.Status{
-			Synced: false,
-		},
+	secret.Labels = labelsMap
+	secret.CreatedAt = timestamppb.Now()
+	secret.Status = &pb.Status{
+		Synced: false,
 	}
 
 	createSecretRequest := pb.CreateSecretRequest{
-		Secret: &secret,
+		Secret: secret,
 	}
 	resp, err := c.grpc.CreateSecret(c.ctx, &createSecretRequest)
 	if err != nil {
@@ -36,3 +38,38 @@ func (c *client) Create(data []byte, labels []string) (string, error) {
 
 	return resp.GetId(), nil
 }
+
+// CreateSecrets creates secrets in bulk, either all secrets are created or none of them.
+// Labels are added to every secret and override secret labels with the same keys
+func (c *client) CreateSecrets(secrets []*pb.Secret, labels []string) ([]string, error) {
+	labelsMap, err := constructLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+
+	createdAt := timestamppb.Now()
+	for _, secret := range secrets {
+		if secret.Labels == nil {
+			secret.Labels = make(map[string]string, len(labelsMap))
+		}
+		for key, value := range labelsMap {
+			secret.Labels[key] = value
+		}
+		secret.CreatedAt = createdAt
+		secret.Status = &pb.Status{
+			Synced: false,
+		}
+	}
+
+	resp, err := c.grpc.CreateSecrets(c.ctx, &pb.CreateSecretsRequest{
+		Secrets: secrets,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetError() != "" {
+		return nil, errors.New(resp.GetError())
+	}
+
+	return resp.GetIds(), nil
+}
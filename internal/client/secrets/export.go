@@ -0,0 +1,40 @@
+package secrets
+
+import (
+	"errors"
+	"math"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+// Export returns all secrets of the vault decrypted with their prior versions
+func (c *client) Export() ([]*pb.ExportedSecret, error) {
+	// The export carries the whole vault in a single message
+	resp, err := c.grpc.ExportSecrets(c.ctx, &pb.ExportSecretsRequest{}, grpc.MaxCallRecvMsgSize(math.MaxInt32))
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetError() != "" {
+		return nil, errors.New(resp.GetError())
+	}
+
+	return resp.GetSecrets(), nil
+}
+
+// RestoreSecrets restores exported secrets keeping their IDs, returns IDs of restored secrets
+// and IDs of secrets skipped as they already exist in the vault
+func (c *client) RestoreSecrets(secrets []*pb.ExportedSecret) ([]string, []string, error) {
+	resp, err := c.grpc.RestoreSecrets(c.ctx, &pb.RestoreSecretsRequest{
+		Secrets: secrets,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if resp.GetError() != "" {
+		return nil, nil, errors.New(resp.GetError())
+	}
+
+	return resp.GetIds(), resp.GetSkipped(), nil
+}
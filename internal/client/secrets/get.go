@@ -6,7 +6,8 @@ import (
 
 func (c *client) Get(id string) (*pb.Secret, error) {
 	resp, err := c.grpc.GetSecret(c.ctx, &pb.GetSecretRequest{
-		Id: id,
+		Id:      id,
+		Decrypt: true,
 	})
 	if err != nil {
 		return nil, err
@@ -14,3 +15,25 @@ func (c *client) Get(id string) (*pb.Secret, error) {
 
 	return resp.GetSecret(), nil
 }
+
+// Select returns decrypted secrets matching the label selector
+func (c *client) Select(selector string) ([]*pb.Secret, error) {
+	resp, err := c.grpc.ListSecrets(c.ctx, &pb.ListSecretsRequest{
+		Selector: selector,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	secrets := make([]*pb.Secret, 0, len(resp.GetSecrets()))
+	for _, secret := range resp.GetSecrets() {
+		decrypted, err := c.Get(secret.GetID())
+		if err != nil {
+			return nil, err
+		}
+
+		secrets = append(secrets, decrypted)
+	}
+
+	return secrets, nil
+}
@@ -12,3 +12,13 @@ func (c *client) List() ([]*pb.Secret, error) {
 
 	return resp.GetSecrets(), nil
 }
+
+// Query lists a page of secrets matching the request and the total number of matching secrets
+func (c *client) Query(request *pb.ListSecretsRequest) ([]*pb.Secret, uint32, error) {
+	resp, err := c.grpc.ListSecrets(c.ctx, request)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp.GetSecrets(), resp.GetTotal(), nil
+}
@@ -0,0 +1,23 @@
+package secrets
+
+import (
+	"errors"
+	"time"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+// OTP returns the one-time password computed by the agent and the time the code stays valid
+func (c *client) OTP(id string) (string, time.Duration, error) {
+	resp, err := c.grpc.GetSecretOTP(c.ctx, &pb.GetSecretOTPRequest{
+		Id: id,
+	})
+	if err != nil {
+		return "", 0, err
+	}
+	if resp.GetError() != "" {
+		return "", 0, errors.New(resp.GetError())
+	}
+
+	return resp.GetCode(), time.Duration(resp.GetRemaining()) * time.Second, nil
+}
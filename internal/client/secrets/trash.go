@@ -0,0 +1,32 @@
+package secrets
+
+import (
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+func (c *client) Trash() ([]*pb.Secret, error) {
+	resp, err := c.grpc.ListSecrets(c.ctx, &pb.ListSecretsRequest{
+		Deleted: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GetSecrets(), nil
+}
+
+func (c *client) Undelete(id string) (string, error) {
+	resp, err := c.grpc.UndeleteSecret(c.ctx, &pb.UndeleteSecretRequest{
+		Id: id,
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.GetError() != "" {
+		return "", errors.New(resp.GetError())
+	}
+
+	return resp.GetId(), nil
+}
@@ -8,6 +8,7 @@ import (
 	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
 )
 
+// Update replaces data and labels of the secret, the data of the typed secret replaces its main content
 func (c *client) Update(id string, data []byte, labels []string) (string, error) {
 	labelsMap, err := constructLabels(labels)
 	if err != nil {
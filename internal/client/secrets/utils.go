@@ -3,6 +3,9 @@ package secrets
 import (
 	"errors"
 	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var (
@@ -23,3 +26,8 @@ func constructLabels(labels []string) (map[string]string, error) {
 
 	return labelsMap, nil
 }
+
+// IsLocked reports whether the agent refused the request until it is unlocked
+func IsLocked(err error) bool {
+	return status.Code(err) == codes.FailedPrecondition
+}
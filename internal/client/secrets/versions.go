@@ -0,0 +1,36 @@
+package secrets
+
+import (
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto" // import protobufs
+)
+
+func (c *client) History(id string) ([]*pb.SecretVersion, error) {
+	resp, err := c.grpc.ListSecretVersions(c.ctx, &pb.ListSecretVersionsRequest{
+		Id: id,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetError() != "" {
+		return nil, errors.New(resp.GetError())
+	}
+
+	return resp.GetVersions(), nil
+}
+
+func (c *client) Restore(id string, version uint64) (string, error) {
+	resp, err := c.grpc.RestoreSecretVersion(c.ctx, &pb.RestoreSecretVersionRequest{
+		Id:      id,
+		Version: version,
+	})
+	if err != nil {
+		return "", err
+	}
+	if resp.GetError() != "" {
+		return "", errors.New(resp.GetError())
+	}
+
+	return resp.GetId(), nil
+}
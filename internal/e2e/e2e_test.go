@@ -0,0 +1,567 @@
+//go:build linux
+
+package e2e_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/go-rfe/gpwd/internal/client/accounts"
+	"github.com/go-rfe/gpwd/internal/client/secrets"
+	"github.com/go-rfe/gpwd/internal/e2e"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const (
+	masterPassword = "correct horse battery staple"
+	username       = "igortiunov"
+	userPassword   = "user password"
+	callTimeout    = time.Minute
+
+	// The watch reconnects within a few backoff attempts, changes pushed on watch are pulled well before
+	// agents poll the server every 30 seconds
+	watchTimeout       = 20 * time.Second
+	propagationTimeout = 10 * time.Second
+)
+
+func TestAccountRegistration(t *testing.T) {
+	server := e2e.NewServer(t)
+	first := server.NewAgent(t, masterPassword)
+	second := server.NewAgent(t, masterPassword)
+	stranger := server.NewAgent(t, masterPassword)
+	forgetful := server.NewAgent(t, "another master password")
+
+	createAccount(t, first, userPassword)
+	if err := first.SyncNow(); err != nil {
+		t.Fatalf("SyncNow() of the first device error = %v", err)
+	}
+	if account := getAccount(t, first); !account.GetRegistered() {
+		t.Fatalf("account of the first device isn't registered: %v", account)
+	}
+
+	// The secret created before joining the account is re-encrypted with the vault key of the account
+	secondSecrets := newSecretsClient(t, second)
+	id, err := secondSecrets.Create([]byte("before join"), nil)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	// The account registered from another device is logged in
+	createAccount(t, second, userPassword)
+	if err := second.SyncNow(); err != nil {
+		t.Fatalf("SyncNow() of the second device error = %v", err)
+	}
+	if account := getAccount(t, second); !account.GetRegistered() {
+		t.Fatalf("account of the second device isn't registered: %v", account)
+	}
+
+	secret, err := secondSecrets.Get(id)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(secret.GetData()) != "before join" {
+		t.Fatalf("Get() data = %q, want %q", secret.GetData(), "before join")
+	}
+
+	if _, err := newAccountsClient(t, stranger).Create(e2e.ServerAddress, username, []byte("wrong password"), []byte(masterPassword)); err == nil {
+		t.Fatal("Create() account with the wrong password error = nil, want error")
+	}
+
+	// The vault key of the account is wrapped with the master password of the first device
+	if _, err := newAccountsClient(t, forgetful).Create(e2e.ServerAddress, username, []byte(userPassword), []byte("another master password")); err == nil {
+		t.Fatal("Create() account with another master password error = nil, want error")
+	}
+	if _, err := newAccountsClient(t, forgetful).Get(); err == nil {
+		t.Fatal("Get() account which failed to join error = nil, want error")
+	}
+}
+
+func TestAccountCreateTwice(t *testing.T) {
+	server := e2e.NewServer(t)
+	device := server.NewAgent(t, masterPassword)
+
+	createAccount(t, device, userPassword)
+
+	client := newAccountsClient(t, device)
+	if _, err := client.Create(e2e.ServerAddress, username, []byte(userPassword), []byte(masterPassword)); err == nil {
+		t.Fatal("Create() of the second account error = nil, want error")
+	}
+}
+
+func TestSecretPropagation(t *testing.T) {
+	server := e2e.NewServer(t)
+	first := server.NewAgent(t, masterPassword)
+	second := server.NewAgent(t, masterPassword)
+
+	for _, device := range []*e2e.Agent{first, second} {
+		createAccount(t, device, userPassword)
+		syncNow(t, device)
+	}
+
+	firstSecrets := newSecretsClient(t, first)
+	secondSecrets := newSecretsClient(t, second)
+
+	id, err := firstSecrets.Create([]byte("created"), []string{"site=example.com"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	created, err := firstSecrets.Get(id)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if string(created.GetData()) != "created" {
+		t.Fatalf("Get() data = %q, want %q", created.GetData(), "created")
+	}
+
+	syncNow(t, first)
+	syncNow(t, second)
+
+	pushed := findSecret(t, firstSecrets.List, id)
+	pulled := findSecret(t, secondSecrets.List, id)
+	assertPropagated(t, pushed, pulled)
+	assertData(t, secondSecrets, id, "created")
+	if pulled.GetLabels()["site"] != "example.com" {
+		t.Fatalf("pulled labels = %v, want site=example.com", pulled.GetLabels())
+	}
+
+	// The second device changes the secret on top of the pulled revision
+	if _, err := secondSecrets.Update(id, []byte("updated"), []string{"site=example.org"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	syncNow(t, second)
+	syncNow(t, first)
+
+	pushed = findSecret(t, secondSecrets.List, id)
+	pulled = findSecret(t, firstSecrets.List, id)
+	assertPropagated(t, pushed, pulled)
+	assertData(t, firstSecrets, id, "updated")
+	if pulled.GetRevision() <= created.GetRevision() {
+		t.Fatalf("pulled revision = %d, want above %d", pulled.GetRevision(), created.GetRevision())
+	}
+	if pulled.GetLabels()["site"] != "example.org" {
+		t.Fatalf("pulled labels = %v, want site=example.org", pulled.GetLabels())
+	}
+
+	if err := firstSecrets.Delete(id); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	syncNow(t, first)
+	syncNow(t, second)
+
+	if secret := lookupSecret(t, secondSecrets.List, id); secret != nil {
+		t.Fatalf("deleted secret is listed on the second device: %v", secret)
+	}
+
+	pushed = findSecret(t, firstSecrets.Trash, id)
+	pulled = findSecret(t, secondSecrets.Trash, id)
+	assertPropagated(t, pushed, pulled)
+}
+
+// TestSyncResume retries changes committed by the server whose acknowledgements were lost,
+// retried changes are acknowledged again instead of failing the sync or conflicting with themselves
+func TestSyncResume(t *testing.T) {
+	server := e2e.NewServer(t)
+	first := server.NewAgent(t, masterPassword)
+	second := server.NewAgent(t, masterPassword)
+
+	for _, device := range []*e2e.Agent{first, second} {
+		createAccount(t, device, userPassword)
+		syncNow(t, device)
+	}
+
+	firstSecrets := newSecretsClient(t, first)
+	secondSecrets := newSecretsClient(t, second)
+
+	// Changes are pushed by the sync requested on change or by SyncNow, whichever comes first
+	var id string
+	for _, change := range []struct {
+		name  string
+		apply func() error
+	}{
+		{"create", func() error {
+			var err error
+			id, err = firstSecrets.Create([]byte("created"), []string{"site=example.com"})
+			return err
+		}},
+		{"update", func() error {
+			_, err := firstSecrets.Update(id, []byte("updated"), []string{"site=example.com"})
+			return err
+		}},
+		{"delete", func() error {
+			return firstSecrets.Delete(id)
+		}},
+	} {
+		server.DropNextAck()
+
+		if err := change.apply(); err != nil {
+			t.Fatalf("%s error = %v", change.name, err)
+		}
+
+		_ = first.SyncNow()
+		if !server.AckDropped() {
+			t.Fatalf("acknowledgement of %s isn't dropped", change.name)
+		}
+
+		syncNow(t, first)
+
+		conflicts, err := firstSecrets.Conflicts()
+		if err != nil {
+			t.Fatalf("Conflicts() error = %v", err)
+		}
+		if len(conflicts) != 0 {
+			t.Fatalf("retried %s conflicts = %v, want none", change.name, conflicts)
+		}
+
+		list := firstSecrets.List
+		if change.name == "delete" {
+			list = firstSecrets.Trash
+		}
+		if pushed := findSecret(t, list, id); !pushed.GetStatus().GetSynced() {
+			t.Fatalf("retried %s isn't synced: %v", change.name, pushed.GetStatus())
+		}
+	}
+
+	syncNow(t, second)
+
+	pushed := findSecret(t, firstSecrets.Trash, id)
+	pulled := findSecret(t, secondSecrets.Trash, id)
+	assertPropagated(t, pushed, pulled)
+	if pulled.GetRevision() != 3 {
+		t.Errorf("pulled revision = %d, want 3", pulled.GetRevision())
+	}
+}
+
+// TestWatchSync propagates the change to the device watching the server without SyncNow
+// before the device would poll the server
+func TestWatchSync(t *testing.T) {
+	server := e2e.NewServer(t)
+	first := server.NewAgent(t, masterPassword)
+	second := server.NewAgent(t, masterPassword)
+
+	for _, device := range []*e2e.Agent{first, second} {
+		createAccount(t, device, userPassword)
+		syncNow(t, device)
+	}
+
+	// The watch is reconnected with backoff after the account is created
+	waitFor(t, "watching the server", watchTimeout, func() bool {
+		status, err := second.SyncStatus()
+		return err == nil && status.GetWatching()
+	})
+
+	id, err := newSecretsClient(t, first).Create([]byte("created"), nil)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	syncNow(t, first)
+
+	secondSecrets := newSecretsClient(t, second)
+	waitFor(t, "secret pulled on watch", propagationTimeout, func() bool {
+		return lookupSecret(t, secondSecrets.List, id) != nil
+	})
+	assertData(t, secondSecrets, id, "created")
+}
+
+// Secrets of other users aren't propagated
+func TestSecretIsolation(t *testing.T) {
+	server := e2e.NewServer(t)
+	owner := server.NewAgent(t, masterPassword)
+	other := server.NewAgent(t, masterPassword)
+
+	createAccount(t, owner, userPassword)
+	if _, err := newAccountsClient(t, other).Create(e2e.ServerAddress, "other", []byte(userPassword), []byte(masterPassword)); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	id, err := newSecretsClient(t, owner).Create([]byte("private"), nil)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	syncNow(t, owner)
+	syncNow(t, other)
+
+	if secret := lookupSecret(t, newSecretsClient(t, other).List, id); secret != nil {
+		t.Fatalf("secret of another user is listed: %v", secret)
+	}
+}
+
+// TestExportRestore restores the export into another vault, so secrets are re-encrypted with another data key
+func TestExportRestore(t *testing.T) {
+	server := e2e.NewServer(t)
+	source := newSecretsClient(t, server.NewAgent(t, masterPassword))
+
+	id, err := source.Create([]byte("first"), []string{"env=prod"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := source.Update(id, []byte("second"), []string{"env=prod"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	deletedID, err := source.Create([]byte("deleted"), nil)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if err := source.Delete(deletedID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+
+	exported, err := source.Export()
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	if len(exported) != 2 {
+		t.Fatalf("Export() = %d secrets, want 2", len(exported))
+	}
+
+	target := newSecretsClient(t, server.NewAgent(t, "another master password"))
+
+	ids, skipped, err := target.RestoreSecrets(exported)
+	if err != nil {
+		t.Fatalf("RestoreSecrets() error = %v", err)
+	}
+	if len(ids) != 2 || len(skipped) != 0 {
+		t.Fatalf("RestoreSecrets() = %v restored, %v skipped, want 2 restored", ids, skipped)
+	}
+
+	restored, err := target.Get(id)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !bytes.Equal(restored.GetData(), []byte("second")) || restored.GetLabels()["env"] != "prod" {
+		t.Errorf("restored secret = %v, want the latest data with labels", restored)
+	}
+
+	versions, err := target.History(id)
+	if err != nil {
+		t.Fatalf("History() error = %v", err)
+	}
+	if len(versions) != 1 || versions[0].GetVersion() != 1 {
+		t.Fatalf("History() = %v, want the first version", versions)
+	}
+
+	trashed := lookupSecret(t, target.Trash, deletedID)
+	if trashed == nil {
+		t.Fatal("deleted secret isn't restored to the trash")
+	}
+
+	// Restoring again keeps existing secrets
+	ids, skipped, err = target.RestoreSecrets(exported)
+	if err != nil {
+		t.Fatalf("RestoreSecrets() again error = %v", err)
+	}
+	if len(ids) != 0 || len(skipped) != 2 {
+		t.Errorf("RestoreSecrets() again = %v restored, %v skipped, want 2 skipped", ids, skipped)
+	}
+}
+
+// TestRestoreSynced restores the backup on a new device of the account, restored secrets are pushed
+// as new ones and acknowledged with revisions the server already has instead of conflicting
+func TestRestoreSynced(t *testing.T) {
+	server := e2e.NewServer(t)
+	first := server.NewAgent(t, masterPassword)
+	second := server.NewAgent(t, masterPassword)
+
+	createAccount(t, first, userPassword)
+	firstSecrets := newSecretsClient(t, first)
+
+	id, err := firstSecrets.Create([]byte("first"), []string{"env=prod"})
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, err := firstSecrets.Update(id, []byte("second"), []string{"env=prod"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+	syncNow(t, first)
+
+	exported, err := firstSecrets.Export()
+	if err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+
+	secondSecrets := newSecretsClient(t, second)
+	if _, _, err := secondSecrets.RestoreSecrets(exported); err != nil {
+		t.Fatalf("RestoreSecrets() error = %v", err)
+	}
+
+	createAccount(t, second, userPassword)
+	syncNow(t, second)
+
+	conflicts, err := secondSecrets.Conflicts()
+	if err != nil {
+		t.Fatalf("Conflicts() error = %v", err)
+	}
+	if len(conflicts) != 0 {
+		t.Fatalf("Conflicts() of restored secrets = %v, want none", conflicts)
+	}
+
+	pushed := findSecret(t, firstSecrets.List, id)
+	restored := findSecret(t, secondSecrets.List, id)
+	if !restored.GetStatus().GetSynced() || restored.GetRevision() != pushed.GetRevision() {
+		t.Fatalf("restored secret status = %v, revision = %d, want synced revision %d",
+			restored.GetStatus(), restored.GetRevision(), pushed.GetRevision())
+	}
+	assertData(t, secondSecrets, id, "second")
+
+	// The restored secret is changed on top of the server revision
+	if _, err := secondSecrets.Update(id, []byte("third"), []string{"env=prod"}); err != nil {
+		t.Fatalf("Update() error = %v", err)
+	}
+
+	syncNow(t, second)
+	syncNow(t, first)
+
+	assertPropagated(t, findSecret(t, secondSecrets.List, id), findSecret(t, firstSecrets.List, id))
+	assertData(t, firstSecrets, id, "third")
+}
+
+func assertPropagated(t *testing.T, pushed, pulled *pb.Secret) {
+	t.Helper()
+
+	if !pushed.GetStatus().GetSynced() || !pulled.GetStatus().GetSynced() {
+		t.Fatalf("secrets aren't synced: pushed %v, pulled %v", pushed.GetStatus(), pulled.GetStatus())
+	}
+	if pushed.GetRevision() != pulled.GetRevision() {
+		t.Fatalf("pulled revision = %d, want %d", pulled.GetRevision(), pushed.GetRevision())
+	}
+	if !bytes.Equal(pushed.GetData(), pulled.GetData()) {
+		t.Fatal("pulled data differs from the pushed one")
+	}
+	if pushed.GetStatus().GetDeleted() != pulled.GetStatus().GetDeleted() {
+		t.Fatalf("pulled deleted = %t, want %t", pulled.GetStatus().GetDeleted(), pushed.GetStatus().GetDeleted())
+	}
+}
+
+// assertData checks the secret is decrypted on the device
+func assertData(t *testing.T, client secretsClient, id, want string) {
+	t.Helper()
+
+	secret, err := client.Get(id)
+	if err != nil {
+		t.Fatalf("Get(%s) error = %v", id, err)
+	}
+	if string(secret.GetData()) != want {
+		t.Fatalf("Get(%s) data = %q, want %q", id, secret.GetData(), want)
+	}
+}
+
+func findSecret(t *testing.T, list func() ([]*pb.Secret, error), id string) *pb.Secret {
+	t.Helper()
+
+	secret := lookupSecret(t, list, id)
+	if secret == nil {
+		t.Fatalf("secret %s isn't listed", id)
+	}
+
+	return secret
+}
+
+func lookupSecret(t *testing.T, list func() ([]*pb.Secret, error), id string) *pb.Secret {
+	t.Helper()
+
+	listed, err := list()
+	if err != nil {
+		t.Fatalf("List() error = %v", err)
+	}
+
+	for _, secret := range listed {
+		if secret.GetID() == id {
+			return secret
+		}
+	}
+
+	return nil
+}
+
+func createAccount(t *testing.T, device *e2e.Agent, password string) {
+	t.Helper()
+
+	if _, err := newAccountsClient(t, device).Create(e2e.ServerAddress, username, []byte(password), []byte(masterPassword)); err != nil {
+		t.Fatalf("Create() account error = %v", err)
+	}
+}
+
+func getAccount(t *testing.T, device *e2e.Agent) *pb.Account {
+	t.Helper()
+
+	account, err := newAccountsClient(t, device).Get()
+	if err != nil {
+		t.Fatalf("Get() account error = %v", err)
+	}
+
+	return account
+}
+
+// waitFor polls the condition until it's met or the timeout expires
+func waitFor(t *testing.T, what string, timeout time.Duration, condition func() bool) {
+	t.Helper()
+
+	for deadline := time.Now().Add(timeout); !condition(); {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out after %v waiting for %s", timeout, what)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+func syncNow(t *testing.T, device *e2e.Agent) {
+	t.Helper()
+
+	if err := device.SyncNow(); err != nil {
+		t.Fatalf("SyncNow() error = %v", err)
+	}
+}
+
+type accountsClient interface {
+	Create(server string, username string, password, masterPassword []byte) (string, error)
+	Get() (*pb.Account, error)
+}
+
+func newAccountsClient(t *testing.T, device *e2e.Agent) accountsClient {
+	t.Helper()
+
+	client, err := accounts.NewAccountsClient(callContext(t), device.SocketPath)
+	if err != nil {
+		t.Fatalf("NewAccountsClient() error = %v", err)
+	}
+
+	return client
+}
+
+type secretsClient interface {
+	Create(data []byte, labels []string) (string, error)
+	Get(id string) (*pb.Secret, error)
+	Update(id string, data []byte, labels []string) (string, error)
+	Delete(id string) error
+	List() ([]*pb.Secret, error)
+	Trash() ([]*pb.Secret, error)
+	History(id string) ([]*pb.SecretVersion, error)
+	Export() ([]*pb.ExportedSecret, error)
+	RestoreSecrets(secrets []*pb.ExportedSecret) ([]string, []string, error)
+	Conflicts() ([]*pb.SecretConflict, error)
+}
+
+func newSecretsClient(t *testing.T, device *e2e.Agent) secretsClient {
+	t.Helper()
+
+	client, err := secrets.NewSecretsClient(callContext(t), device.SocketPath)
+	if err != nil {
+		t.Fatalf("NewSecretsClient() error = %v", err)
+	}
+
+	return client
+}
+
+// callContext is canceled on the test cleanup before agents are stopped, so client connections are closed first
+func callContext(t *testing.T) context.Context {
+	ctx, cancel := context.WithTimeout(context.Background(), callTimeout)
+	t.Cleanup(cancel)
+
+	return ctx
+}
@@ -0,0 +1,278 @@
+// Package e2e runs the server and agents in the test process. The server is served over bufconn with
+// the in-memory store and agents dial it through the in-memory listener. Agents accept only peers
+// with credentials of the unix socket, so they are served over sockets in a temporary directory
+package e2e
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/go-rfe/gpwd/internal/agent"
+	agentclient "github.com/go-rfe/gpwd/internal/client/agent"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/server"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+const (
+	// ServerAddress is the account server address, it matches the name of the test TLS certificate
+	ServerAddress = "localhost:8080"
+
+	bufSize       = 1 << 20
+	syncInterval  = 30 * time.Second
+	stopTimeout   = 30 * time.Second
+	tokenLifespan = time.Hour
+)
+
+// Server is the server running in the test process
+type Server struct {
+	listener *bufconn.Listener
+	certPath string
+	keyPath  string
+	dropAck  int32 // dropAck* state, accessed atomically
+}
+
+const (
+	dropAckNone int32 = iota
+	dropAckPending
+	dropAckDone
+)
+
+// Agent is the agent running in the test process, clients connect to the SocketPath
+type Agent struct {
+	SocketPath string
+}
+
+// NewServer starts the server with the in-memory store and throwaway TLS material,
+// the server is stopped on the test cleanup after agents started by it
+func NewServer(t *testing.T) *Server {
+	t.Helper()
+
+	dir := tempDir(t)
+	certPath, keyPath := writeTLS(t, dir)
+
+	// Clients read the certificates from the global config
+	viper.Set("cert_path", certPath)
+	viper.Set("server_cert_path", certPath)
+
+	s := &Server{
+		listener: bufconn.Listen(bufSize),
+		certPath: certPath,
+		keyPath:  keyPath,
+	}
+
+	srv := server.NewServer(&server.Cfg{
+		ServerAddress: ServerAddress,
+		TokenLifespan: tokenLifespan,
+		DatabaseDSN:   "memory://",
+		CertPath:      certPath,
+		KeyPath:       keyPath,
+
+		StreamInterceptor: s.dropAckInterceptor,
+	})
+
+	serve(t, "server", func(ctx context.Context) error {
+		return srv.Serve(ctx, s.listener)
+	})
+
+	return s
+}
+
+// DropNextAck makes the server end the next sync stream after the pushed change is committed
+// and before it's acknowledged, the way a dropped connection does
+func (s *Server) DropNextAck() {
+	atomic.StoreInt32(&s.dropAck, dropAckPending)
+}
+
+// AckDropped reports whether the acknowledgement requested by DropNextAck was dropped
+func (s *Server) AckDropped() bool {
+	return atomic.LoadInt32(&s.dropAck) == dropAckDone
+}
+
+func (s *Server) dropAckInterceptor(
+	srv interface{},
+	stream grpc.ServerStream,
+	_ *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	return handler(srv, &dropAckStream{ServerStream: stream, server: s})
+}
+
+// dropAckStream fails sending the acknowledgement requested to be dropped
+type dropAckStream struct {
+	grpc.ServerStream
+	server *Server
+}
+
+func (s *dropAckStream) SendMsg(m interface{}) error {
+	if response, ok := m.(*pb.SyncResponse); ok && response.GetAck() != nil &&
+		atomic.CompareAndSwapInt32(&s.server.dropAck, dropAckPending, dropAckDone) {
+		return status.Error(codes.Unavailable, "connection dropped before acknowledgement")
+	}
+
+	return s.ServerStream.SendMsg(m)
+}
+
+// NewAgent starts the agent with the in-memory vault, the agent connects to the server over bufconn
+func (s *Server) NewAgent(t *testing.T, masterPassword string) *Agent {
+	t.Helper()
+
+	dir := tempDir(t)
+	socketPath := filepath.Join(dir, "agent.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("failed to create agent listener: %v", err)
+	}
+
+	a := agent.NewAgent(&agent.Cfg{
+		SocketPath:     socketPath,
+		SyncInterval:   syncInterval,
+		StorePath:      dir,
+		StoreBackend:   local.MemoryBackend,
+		CertPath:       s.certPath,
+		KeyPath:        s.keyPath,
+		MasterPassword: []byte(masterPassword),
+		KDFTime:        1,
+		KDFMemory:      1024,
+		KDFThreads:     1,
+		ServerDialer: func(ctx context.Context, _ string) (net.Conn, error) {
+			return s.listener.DialContext(ctx)
+		},
+	})
+
+	serve(t, "agent", func(ctx context.Context) error {
+		return a.Serve(ctx, listener)
+	})
+
+	return &Agent{
+		SocketPath: socketPath,
+	}
+}
+
+// SyncNow syncs the agent with the server and waits until it's done
+func (a *Agent) SyncNow() error {
+	ctx, cancel := context.WithTimeout(context.Background(), syncInterval)
+	defer cancel()
+
+	client, err := agentclient.NewAgentClient(ctx, a.SocketPath)
+	if err != nil {
+		return err
+	}
+
+	return client.SyncNow()
+}
+
+// SyncStatus returns the sync status of the agent
+func (a *Agent) SyncStatus() (*pb.SyncStatusResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), syncInterval)
+	defer cancel()
+
+	client, err := agentclient.NewAgentClient(ctx, a.SocketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.SyncStatus()
+}
+
+// serve runs the component until the test cleanup
+func serve(t *testing.T, name string, run func(ctx context.Context) error) {
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+
+	go func() {
+		done <- run(ctx)
+	}()
+
+	t.Cleanup(func() {
+		cancel()
+
+		select {
+		case err := <-done:
+			if err != nil {
+				t.Errorf("%s stopped with error: %v", name, err)
+			}
+		case <-time.After(stopTimeout):
+			t.Errorf("%s didn't stop in %s", name, stopTimeout)
+		}
+	})
+}
+
+// tempDir returns a short directory, the unix socket path is limited to about a hundred bytes
+func tempDir(t *testing.T) string {
+	dir, err := os.MkdirTemp("", "gpwd-e2e")
+	if err != nil {
+		t.Fatalf("failed to create temporary directory: %v", err)
+	}
+
+	t.Cleanup(func() {
+		if err := os.RemoveAll(dir); err != nil {
+			t.Errorf("failed to remove temporary directory: %v", err)
+		}
+	})
+
+	return dir
+}
+
+// writeTLS writes the self-signed certificate of localhost and its key
+func writeTLS(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate TLS key: %v", err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "localhost"},
+		DNSNames:              []string{"localhost"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	cert, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("failed to create TLS certificate: %v", err)
+	}
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("failed to marshal TLS key: %v", err)
+	}
+
+	certPath := filepath.Join(dir, "cert.pem")
+	keyPath := filepath.Join(dir, "key.pem")
+
+	for path, block := range map[string]*pem.Block{
+		certPath: {Type: "CERTIFICATE", Bytes: cert},
+		keyPath:  {Type: "PRIVATE KEY", Bytes: keyDER},
+	} {
+		if err := os.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+			t.Fatalf("failed to write %s: %v", path, err)
+		}
+	}
+
+	return certPath, keyPath
+}
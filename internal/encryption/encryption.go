@@ -4,9 +4,12 @@ import (
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"errors"
 	"io"
 )
 
+var ErrMalformedCiphertext = errors.New("ciphertext is too short")
+
 func GetCrypto(key []byte) (func([]byte) ([]byte, error), func([]byte) ([]byte, error), error) {
 	cryptoCipher, err := aes.NewCipher(key)
 	if err != nil {
@@ -17,10 +20,10 @@ func GetCrypto(key []byte) (func([]byte) ([]byte, error), func([]byte) ([]byte,
 		return nil, nil, err
 	}
 	nonceSize := gcm.NonceSize()
-	nonce := make([]byte, nonceSize)
 
 	encrypt := func(plaintext []byte) ([]byte, error) {
-		if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		nonce := make([]byte, nonceSize)
+		if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
 			return nil, err
 		}
 
@@ -28,6 +31,10 @@ func GetCrypto(key []byte) (func([]byte) ([]byte, error), func([]byte) ([]byte,
 	}
 
 	decrypt := func(ciphertext []byte) ([]byte, error) {
+		if len(ciphertext) < nonceSize {
+			return nil, ErrMalformedCiphertext
+		}
+
 		return gcm.Open(nil, ciphertext[:nonceSize], ciphertext[nonceSize:], nil)
 	}
 
@@ -0,0 +1,172 @@
+package encryption
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestDeriveKey(t *testing.T) {
+	params, err := NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatalf("NewKDFParams() error = %v", err)
+	}
+	if params.Algorithm != KDFArgon2id || len(params.Salt) != saltLength {
+		t.Fatalf("NewKDFParams() = %+v, want Argon2id with %d bytes of salt", params, saltLength)
+	}
+
+	other, err := NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatalf("NewKDFParams() error = %v", err)
+	}
+	if bytes.Equal(params.Salt, other.Salt) {
+		t.Error("NewKDFParams() returned the same salt twice")
+	}
+
+	key, err := DeriveKey([]byte("password"), params)
+	if err != nil {
+		t.Fatalf("DeriveKey() error = %v", err)
+	}
+	if len(key) != keyLength {
+		t.Errorf("DeriveKey() key length = %d, want %d", len(key), keyLength)
+	}
+
+	again, err := DeriveKey([]byte("password"), params)
+	if err != nil {
+		t.Fatalf("DeriveKey() error = %v", err)
+	}
+	if !bytes.Equal(key, again) {
+		t.Error("DeriveKey() isn't deterministic for the same password and parameters")
+	}
+
+	salted, err := DeriveKey([]byte("password"), other)
+	if err != nil {
+		t.Fatalf("DeriveKey() error = %v", err)
+	}
+	if bytes.Equal(key, salted) {
+		t.Error("DeriveKey() gives the same key for another salt")
+	}
+
+	if _, err := DeriveKey([]byte("password"), &KDFParams{Algorithm: "scrypt"}); !errors.Is(err, ErrUnsupportedKDF) {
+		t.Errorf("DeriveKey() of unknown algorithm error = %v, want %v", err, ErrUnsupportedKDF)
+	}
+
+	defaults, err := NewKDFParams(0, 0, 0)
+	if err != nil {
+		t.Fatalf("NewKDFParams() error = %v", err)
+	}
+	if defaults.Time != DefaultKDFTime || defaults.Memory != DefaultKDFMemory || defaults.Threads != DefaultKDFThreads {
+		t.Errorf("NewKDFParams(0, 0, 0) = %+v, want defaults", defaults)
+	}
+}
+
+func TestDerivedKeyCrypto(t *testing.T) {
+	params, err := NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatalf("NewKDFParams() error = %v", err)
+	}
+
+	key, err := DeriveKey([]byte("password"), params)
+	if err != nil {
+		t.Fatalf("DeriveKey() error = %v", err)
+	}
+
+	encrypt, decrypt, err := GetCrypto(key)
+	if err != nil {
+		t.Fatalf("GetCrypto() error = %v", err)
+	}
+
+	first, err := encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatalf("encrypt() error = %v", err)
+	}
+	second, err := encrypt([]byte("secret"))
+	if err != nil {
+		t.Fatalf("encrypt() error = %v", err)
+	}
+	if bytes.Equal(first, second) {
+		t.Error("encrypt() reused the nonce")
+	}
+
+	plaintext, err := decrypt(first)
+	if err != nil {
+		t.Fatalf("decrypt() error = %v", err)
+	}
+	if string(plaintext) != "secret" {
+		t.Errorf("decrypt() = %q, want %q", plaintext, "secret")
+	}
+
+	wrongKey, err := DeriveKey([]byte("wrong password"), params)
+	if err != nil {
+		t.Fatalf("DeriveKey() error = %v", err)
+	}
+	_, wrongDecrypt, err := GetCrypto(wrongKey)
+	if err != nil {
+		t.Fatalf("GetCrypto() error = %v", err)
+	}
+	if _, err := wrongDecrypt(first); err == nil {
+		t.Error("decrypt() with the key of the wrong password succeeded")
+	}
+
+	if _, err := decrypt(first[:5]); !errors.Is(err, ErrMalformedCiphertext) {
+		t.Errorf("decrypt() of short ciphertext error = %v, want %v", err, ErrMalformedCiphertext)
+	}
+}
+
+func TestWrapKey(t *testing.T) {
+	dataKey, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("NewDataKey() error = %v", err)
+	}
+
+	kek, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("NewDataKey() error = %v", err)
+	}
+
+	wrapped, err := WrapKey(kek, dataKey)
+	if err != nil {
+		t.Fatalf("WrapKey() error = %v", err)
+	}
+	if bytes.Contains(wrapped, dataKey) {
+		t.Error("WrapKey() result contains the data key")
+	}
+
+	unwrapped, err := UnwrapKey(kek, wrapped)
+	if err != nil {
+		t.Fatalf("UnwrapKey() error = %v", err)
+	}
+	if !bytes.Equal(unwrapped, dataKey) {
+		t.Error("UnwrapKey() differs from the wrapped key")
+	}
+
+	wrongKey, err := NewDataKey()
+	if err != nil {
+		t.Fatalf("NewDataKey() error = %v", err)
+	}
+	if _, err := UnwrapKey(wrongKey, wrapped); err == nil {
+		t.Error("UnwrapKey() with the wrong key succeeded")
+	}
+}
+
+func TestRecoveryKey(t *testing.T) {
+	key, printable, err := NewRecoveryKey()
+	if err != nil {
+		t.Fatalf("NewRecoveryKey() error = %v", err)
+	}
+	if len(key) != keyLength {
+		t.Errorf("NewRecoveryKey() key length = %d, want %d", len(key), keyLength)
+	}
+
+	parsed, err := ParseRecoveryKey(printable)
+	if err != nil {
+		t.Fatalf("ParseRecoveryKey() error = %v", err)
+	}
+	if !bytes.Equal(parsed, key) {
+		t.Error("ParseRecoveryKey() differs from the recovery key")
+	}
+
+	if _, err := ParseRecoveryKey("not a recovery key!"); err == nil {
+		t.Error("ParseRecoveryKey() of malformed key succeeded")
+	}
+}
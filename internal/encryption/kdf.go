@@ -0,0 +1,66 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+)
+
+const (
+	KDFArgon2id = "argon2id"
+
+	DefaultKDFTime    uint32 = 3
+	DefaultKDFMemory  uint32 = 64 * 1024 // KiB
+	DefaultKDFThreads uint8  = 4
+
+	keyLength  = 32
+	saltLength = 16
+)
+
+var ErrUnsupportedKDF = errors.New("unsupported key derivation function")
+
+// KDFParams describes how a vault key is derived from the master password
+type KDFParams struct {
+	Algorithm string
+	Salt      []byte
+	Time      uint32
+	Memory    uint32
+	Threads   uint8
+}
+
+// NewKDFParams returns Argon2id parameters with a fresh random salt
+func NewKDFParams(time, memory uint32, threads uint8) (*KDFParams, error) {
+	salt := make([]byte, saltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+
+	if time == 0 {
+		time = DefaultKDFTime
+	}
+	if memory == 0 {
+		memory = DefaultKDFMemory
+	}
+	if threads == 0 {
+		threads = DefaultKDFThreads
+	}
+
+	return &KDFParams{
+		Algorithm: KDFArgon2id,
+		Salt:      salt,
+		Time:      time,
+		Memory:    memory,
+		Threads:   threads,
+	}, nil
+}
+
+// DeriveKey derives an AES-256 key from the password
+func DeriveKey(password []byte, params *KDFParams) ([]byte, error) {
+	if params.Algorithm != KDFArgon2id {
+		return nil, ErrUnsupportedKDF
+	}
+
+	return argon2.IDKey(password, params.Salt, params.Time, params.Memory, params.Threads, keyLength), nil
+}
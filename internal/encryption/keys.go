@@ -0,0 +1,52 @@
+package encryption
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"io"
+)
+
+// NewDataKey returns a random AES-256 data encryption key
+func NewDataKey() ([]byte, error) {
+	key := make([]byte, keyLength)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// WrapKey seals the data encryption key with the key encryption key
+func WrapKey(kek, dek []byte) ([]byte, error) {
+	encrypt, _, err := GetCrypto(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	return encrypt(dek)
+}
+
+// UnwrapKey opens the data encryption key sealed with WrapKey
+func UnwrapKey(kek, wrapped []byte) ([]byte, error) {
+	_, decrypt, err := GetCrypto(kek)
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(wrapped)
+}
+
+// NewRecoveryKey returns a random key and its printable form to hand over to the user
+func NewRecoveryKey() ([]byte, string, error) {
+	key, err := NewDataKey()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return key, ToBase64(key), nil
+}
+
+// ParseRecoveryKey decodes the printable form returned by NewRecoveryKey
+func ParseRecoveryKey(recoveryKey string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(recoveryKey)
+}
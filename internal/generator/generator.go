@@ -0,0 +1,151 @@
+package generator
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	DefaultLength = 20
+
+	lowerChars     = "abcdefghijklmnopqrstuvwxyz"
+	upperChars     = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
+	digitChars     = "0123456789"
+	symbolChars    = "!#$%&()*+,-./:;<=>?@[]^_{|}~"
+	ambiguousChars = "0O1Il|"
+)
+
+var (
+	ErrEmptyCharset   = errors.New("at least one character class should be enabled")
+	ErrLengthTooShort = errors.New("length is too short to include every required character class")
+)
+
+// Policy defines the password alphabet and length
+type Policy struct {
+	Length  int
+	Lower   bool
+	Upper   bool
+	Digits  bool
+	Symbols bool
+	// ExcludeAmbiguous drops characters which are easy to confuse, like 0 and O
+	ExcludeAmbiguous bool
+	// RequireEachClass guarantees at least one character of every enabled class
+	RequireEachClass bool
+}
+
+// DefaultPolicy returns the policy with every character class required
+func DefaultPolicy() *Policy {
+	return &Policy{
+		Length:           DefaultLength,
+		Lower:            true,
+		Upper:            true,
+		Digits:           true,
+		Symbols:          true,
+		RequireEachClass: true,
+	}
+}
+
+// Password generates a random password using crypto/rand
+func Password(policy *Policy) ([]byte, error) {
+	classes := policy.classes()
+	if len(classes) == 0 {
+		return nil, ErrEmptyCharset
+	}
+
+	if policy.Length <= 0 || policy.RequireEachClass && policy.Length < len(classes) {
+		return nil, ErrLengthTooShort
+	}
+
+	password := make([]byte, 0, policy.Length)
+
+	if policy.RequireEachClass {
+		for _, class := range classes {
+			char, err := randomChar(class)
+			if err != nil {
+				return nil, err
+			}
+			password = append(password, char)
+		}
+	}
+
+	charset := strings.Join(classes, "")
+	for len(password) < policy.Length {
+		char, err := randomChar(charset)
+		if err != nil {
+			return nil, err
+		}
+		password = append(password, char)
+	}
+
+	// Required characters shouldn't stay at the beginning
+	if err := shuffle(password); err != nil {
+		return nil, err
+	}
+
+	return password, nil
+}
+
+func (p *Policy) classes() []string {
+	var classes []string
+
+	for _, class := range []struct {
+		enabled bool
+		chars   string
+	}{
+		{p.Lower, lowerChars},
+		{p.Upper, upperChars},
+		{p.Digits, digitChars},
+		{p.Symbols, symbolChars},
+	} {
+		if !class.enabled {
+			continue
+		}
+
+		chars := class.chars
+		if p.ExcludeAmbiguous {
+			chars = strings.Map(func(r rune) rune {
+				if strings.ContainsRune(ambiguousChars, r) {
+					return -1
+				}
+				return r
+			}, chars)
+		}
+
+		classes = append(classes, chars)
+	}
+
+	return classes
+}
+
+func randomChar(charset string) (byte, error) {
+	i, err := randomInt(len(charset))
+	if err != nil {
+		return 0, err
+	}
+
+	return charset[i], nil
+}
+
+func randomInt(max int) (int, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(int64(max)))
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n.Int64()), nil
+}
+
+// shuffle permutes data with Fisher-Yates algorithm
+func shuffle(data []byte) error {
+	for i := len(data) - 1; i > 0; i-- {
+		j, err := randomInt(i + 1)
+		if err != nil {
+			return err
+		}
+		data[i], data[j] = data[j], data[i]
+	}
+
+	return nil
+}
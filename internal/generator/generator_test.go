@@ -0,0 +1,106 @@
+package generator
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestPassword(t *testing.T) {
+	for _, length := range []int{4, DefaultLength, 64} {
+		policy := DefaultPolicy()
+		policy.Length = length
+
+		// Repeat to make a missing class unlikely to pass by chance
+		for i := 0; i < 100; i++ {
+			password, err := Password(policy)
+			if err != nil {
+				t.Fatalf("Password(%d): %v", length, err)
+			}
+			if len(password) != length {
+				t.Fatalf("Password(%d) length = %d", length, len(password))
+			}
+
+			for _, class := range []string{lowerChars, upperChars, digitChars, symbolChars} {
+				if !strings.ContainsAny(string(password), class) {
+					t.Fatalf("Password(%d) = %q, no character of %q", length, password, class)
+				}
+			}
+		}
+	}
+}
+
+func TestPasswordClasses(t *testing.T) {
+	password, err := Password(&Policy{Length: 100, Digits: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Trim(string(password), digitChars) != "" {
+		t.Errorf("Password() = %q, want digits only", password)
+	}
+}
+
+func TestPasswordExcludeAmbiguous(t *testing.T) {
+	policy := DefaultPolicy()
+	policy.Length = 1000
+	policy.ExcludeAmbiguous = true
+
+	password, err := Password(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if i := strings.IndexAny(string(password), ambiguousChars); i >= 0 {
+		t.Errorf("Password() contains ambiguous character %q", password[i])
+	}
+}
+
+func TestPasswordErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *Policy
+		err    error
+	}{
+		{"no classes", &Policy{Length: DefaultLength}, ErrEmptyCharset},
+		{"zero length", &Policy{Lower: true}, ErrLengthTooShort},
+		{"shorter than classes", &Policy{Length: 3, Lower: true, Upper: true, Digits: true, Symbols: true, RequireEachClass: true}, ErrLengthTooShort},
+	}
+
+	for _, tt := range tests {
+		if _, err := Password(tt.policy); !errors.Is(err, tt.err) {
+			t.Errorf("Password(%s) error = %v, want %v", tt.name, err, tt.err)
+		}
+	}
+}
+
+func TestPassphrase(t *testing.T) {
+	for _, words := range []int{1, DefaultWords, 12} {
+		policy := DefaultPassphrasePolicy()
+		policy.Words = words
+		// Some words of the list are hyphenated
+		policy.Separator = " "
+
+		passphrase, err := Passphrase(policy)
+		if err != nil {
+			t.Fatalf("Passphrase(%d): %v", words, err)
+		}
+
+		got := strings.Split(string(passphrase), policy.Separator)
+		if len(got) != words {
+			t.Errorf("Passphrase(%d) = %q, %d words", words, passphrase, len(got))
+		}
+	}
+
+	passphrase, err := Passphrase(&PassphrasePolicy{Words: 3, Separator: " ", Capitalize: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, word := range strings.Split(string(passphrase), " ") {
+		if word[:1] != strings.ToUpper(word[:1]) {
+			t.Errorf("Passphrase() word %q is not capitalized", word)
+		}
+	}
+
+	if _, err := Passphrase(&PassphrasePolicy{}); !errors.Is(err, ErrTooFewWords) {
+		t.Errorf("Passphrase(0) error = %v, want %v", err, ErrTooFewWords)
+	}
+}
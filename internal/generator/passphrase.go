@@ -0,0 +1,77 @@
+package generator
+
+import (
+	_ "embed" // embed word list
+	"errors"
+	"strings"
+	"sync"
+)
+
+const (
+	DefaultWords     = 6
+	DefaultSeparator = "-"
+)
+
+var (
+	ErrTooFewWords = errors.New("passphrase should have at least one word")
+
+	// EFF large word list for diceware passphrases, https://www.eff.org/dice
+	//go:embed eff_large_wordlist.txt
+	effLargeWordList string
+
+	wordList     []string
+	wordListOnce sync.Once
+)
+
+// PassphrasePolicy defines the diceware-style passphrase
+type PassphrasePolicy struct {
+	Words      int
+	Separator  string
+	Capitalize bool
+}
+
+// DefaultPassphrasePolicy returns the policy of six words, about 77 bits of entropy
+func DefaultPassphrasePolicy() *PassphrasePolicy {
+	return &PassphrasePolicy{
+		Words:     DefaultWords,
+		Separator: DefaultSeparator,
+	}
+}
+
+// Passphrase picks random words from the embedded word list using crypto/rand
+func Passphrase(policy *PassphrasePolicy) ([]byte, error) {
+	if policy.Words <= 0 {
+		return nil, ErrTooFewWords
+	}
+
+	words := loadWordList()
+	passphrase := make([]string, 0, policy.Words)
+
+	for i := 0; i < policy.Words; i++ {
+		n, err := randomInt(len(words))
+		if err != nil {
+			return nil, err
+		}
+
+		word := words[n]
+		if policy.Capitalize {
+			word = strings.ToUpper(word[:1]) + word[1:]
+		}
+
+		passphrase = append(passphrase, word)
+	}
+
+	return []byte(strings.Join(passphrase, policy.Separator)), nil
+}
+
+// loadWordList parses "<dice rolls>\t<word>" lines of the embedded word list
+func loadWordList() []string {
+	wordListOnce.Do(func() {
+		for _, line := range strings.Split(strings.TrimSpace(effLargeWordList), "\n") {
+			fields := strings.Fields(line)
+			wordList = append(wordList, fields[len(fields)-1])
+		}
+	})
+
+	return wordList
+}
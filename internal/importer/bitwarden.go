@@ -0,0 +1,143 @@
+package importer
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// Bitwarden item types
+const (
+	bitwardenLogin      = 1
+	bitwardenSecureNote = 2
+	bitwardenCard       = 3
+	bitwardenIdentity   = 4
+)
+
+// Bitwarden unencrypted JSON export
+type bitwardenFile struct {
+	Encrypted bool `json:"encrypted"`
+	Folders   []struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"folders"`
+	Items []bitwardenItem `json:"items"`
+}
+
+type bitwardenItem struct {
+	Type     int    `json:"type"`
+	Name     string `json:"name"`
+	Notes    string `json:"notes"`
+	FolderID string `json:"folderId"`
+	Fields   []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"fields"`
+	Login struct {
+		Username string `json:"username"`
+		Password string `json:"password"`
+		TOTP     string `json:"totp"`
+		URIs     []struct {
+			URI string `json:"uri"`
+		} `json:"uris"`
+	} `json:"login"`
+	Card struct {
+		CardholderName string `json:"cardholderName"`
+		Number         string `json:"number"`
+		ExpMonth       string `json:"expMonth"`
+		ExpYear        string `json:"expYear"`
+		Code           string `json:"code"`
+	} `json:"card"`
+}
+
+var (
+	ErrEncryptedExport = errors.New("encrypted export isn't supported, export unencrypted JSON")
+
+	errIdentity = errors.New("identity items aren't supported")
+)
+
+// parseBitwarden reads Bitwarden unencrypted JSON export, folders with nested names like a/b are kept as is
+func parseBitwarden(path string) (*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file bitwardenFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	if file.Encrypted {
+		return nil, ErrEncryptedExport
+	}
+
+	folders := make(map[string]string, len(file.Folders))
+	for _, folder := range file.Folders {
+		folders[folder.ID] = folder.Name
+	}
+
+	result := &Result{}
+	for _, item := range file.Items {
+		e := &entry{
+			name:  item.Name,
+			notes: item.Notes,
+		}
+		if folder := folders[item.FolderID]; folder != "" {
+			e.folder = []string{folder}
+		}
+		for _, field := range item.Fields {
+			e.fields = append(e.fields, [2]string{field.Name, field.Value})
+		}
+
+		switch item.Type {
+		case bitwardenLogin:
+			e.username = item.Login.Username
+			e.password = item.Login.Password
+			e.totp = item.Login.TOTP
+			for _, uri := range item.Login.URIs {
+				if uri.URI != "" {
+					e.uris = append(e.uris, uri.URI)
+				}
+			}
+			result.add(e)
+		case bitwardenSecureNote:
+			result.add(e)
+		case bitwardenCard:
+			result.Secrets = append(result.Secrets, bitwardenCardSecret(e, item))
+		case bitwardenIdentity:
+			result.skip(item.Name, errIdentity)
+		default:
+			result.skip(item.Name, fmt.Errorf("unknown item type %d", item.Type))
+		}
+	}
+
+	return result, nil
+}
+
+func bitwardenCardSecret(e *entry, item bitwardenItem) *pb.Secret {
+	card := item.Card
+
+	// Expiry is MM/YY as entered by gpwd secret create card
+	expiry := ""
+	if month, err := strconv.Atoi(card.ExpMonth); err == nil && len(card.ExpYear) >= 2 {
+		expiry = fmt.Sprintf("%02d/%s", month, card.ExpYear[len(card.ExpYear)-2:])
+	}
+
+	return &pb.Secret{
+		Labels: e.labels(),
+		Data:   e.notesWithFields(),
+		Payload: &pb.Secret_Card{
+			Card: &pb.CardPayload{
+				Holder: card.CardholderName,
+				Number: []byte(card.Number),
+				Expiry: expiry,
+				CVV:    []byte(card.Code),
+			},
+		},
+	}
+}
@@ -0,0 +1,213 @@
+// Package importer converts exports of other password managers into secrets.
+// Entry titles, folders and tags become labels, every tag gets its own label, notes and custom fields are kept in the secret data
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-rfe/gpwd/internal/otp"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const (
+	FormatKeePass   = "keepass"
+	FormatBitwarden = "bitwarden"
+	Format1Password = "1password"
+	FormatPass      = "pass"
+
+	LabelName   = "name"
+	LabelFolder = "folder"
+	// LabelTagPrefix prefixes the label key of every tag set to "true", so secrets are selected by tag,
+	// e.g. tag.work. Spaces in tags are replaced with hyphens
+	LabelTagPrefix = "tag."
+
+	folderSeparator = "/"
+)
+
+var (
+	ErrEmptyEntry = errors.New("entry has neither credentials nor notes")
+
+	parsers = map[string]parser{
+		FormatKeePass:   parseKeePass,
+		FormatBitwarden: parseBitwarden,
+		Format1Password: parse1Password,
+		FormatPass:      parsePass,
+	}
+)
+
+// parser reads the export at the path, a file or a directory depending on the format
+type parser func(path string) (*Result, error)
+
+// Skipped is the entry of the export that isn't imported
+type Skipped struct {
+	Name   string
+	Reason string
+}
+
+// Result holds secrets converted from the export and entries skipped
+type Result struct {
+	Secrets []*pb.Secret
+	Skipped []Skipped
+}
+
+// Formats returns sorted names of supported export formats
+func Formats() []string {
+	formats := make([]string, 0, len(parsers))
+	for format := range parsers {
+		formats = append(formats, format)
+	}
+	sort.Strings(formats)
+
+	return formats
+}
+
+// Parse converts the export of the format into secrets, secrets are returned in the export order
+func Parse(format, path string) (*Result, error) {
+	parse, ok := parsers[format]
+	if !ok {
+		return nil, fmt.Errorf("unknown import format %q, available formats: %v", format, Formats())
+	}
+
+	return parse(path)
+}
+
+// entry is the password manager entry in the common shape
+type entry struct {
+	name     string
+	folder   []string
+	tags     []string
+	username string
+	password string
+	uris     []string
+	totp     string
+	notes    string
+	// fields are custom fields as name and value pairs
+	fields [][2]string
+}
+
+// add converts the entry into the secret or records it as skipped
+func (r *Result) add(e *entry) {
+	secret, err := e.secret()
+	if err != nil {
+		r.skip(e.name, err)
+		return
+	}
+
+	r.Secrets = append(r.Secrets, secret)
+}
+
+func (r *Result) skip(name string, err error) {
+	r.Skipped = append(r.Skipped, Skipped{Name: name, Reason: err.Error()})
+}
+
+// secret returns the login if the entry has credentials and the note otherwise
+func (e *entry) secret() (*pb.Secret, error) {
+	secret := &pb.Secret{
+		Labels: e.labels(),
+	}
+
+	notes := e.notesWithFields()
+
+	switch {
+	case e.username != "" || e.password != "" || len(e.uris) > 0 || e.totp != "":
+		totpSeed, err := e.totpSeed()
+		if err != nil {
+			return nil, err
+		}
+
+		secret.Data = notes
+		secret.Payload = &pb.Secret_Login{
+			Login: &pb.LoginPayload{
+				Username: e.username,
+				Password: []byte(e.password),
+				URIs:     e.uris,
+				TOTPSeed: totpSeed,
+			},
+		}
+	case len(notes) > 0:
+		secret.Payload = &pb.Secret_Note{
+			Note: &pb.NotePayload{
+				Text: notes,
+			},
+		}
+	default:
+		return nil, ErrEmptyEntry
+	}
+
+	return secret, nil
+}
+
+func (e *entry) labels() map[string]string {
+	labels := make(map[string]string)
+	if name := strings.TrimSpace(e.name); name != "" {
+		labels[LabelName] = name
+	}
+	if folder := joinNonEmpty(e.folder, folderSeparator); folder != "" {
+		labels[LabelFolder] = folder
+	}
+	for _, tag := range e.tags {
+		if tag = strings.Join(strings.Fields(tag), "-"); tag != "" {
+			labels[LabelTagPrefix+tag] = "true"
+		}
+	}
+
+	return labels
+}
+
+// notesWithFields appends custom fields to notes one per line
+func (e *entry) notesWithFields() []byte {
+	lines := make([]string, 0, len(e.fields)+1)
+	if notes := strings.TrimSpace(e.notes); notes != "" {
+		lines = append(lines, notes)
+	}
+	for _, field := range e.fields {
+		if field[1] != "" {
+			lines = append(lines, field[0]+": "+field[1])
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil
+	}
+
+	return []byte(strings.Join(lines, "\n"))
+}
+
+// totpSeed normalizes the seed to otpauth:// URI the agent stores
+func (e *entry) totpSeed() ([]byte, error) {
+	if strings.TrimSpace(e.totp) == "" {
+		return nil, nil
+	}
+
+	key, err := otp.Parse(e.totp)
+	if err != nil {
+		return nil, fmt.Errorf("invalid TOTP seed: %w", err)
+	}
+
+	if key.Label == "" {
+		key.Label = e.username
+	}
+
+	return []byte(key.URI()), nil
+}
+
+func joinNonEmpty(values []string, separator string) string {
+	nonEmpty := make([]string, 0, len(values))
+	for _, value := range values {
+		if value = strings.TrimSpace(value); value != "" {
+			nonEmpty = append(nonEmpty, value)
+		}
+	}
+
+	return strings.Join(nonEmpty, separator)
+}
+
+// splitTags splits tags separated by commas or semicolons
+func splitTags(tags string) []string {
+	return strings.FieldsFunc(tags, func(r rune) bool {
+		return r == ',' || r == ';'
+	})
+}
@@ -0,0 +1,260 @@
+package importer
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const totpSecret = "JBSWY3DPEHPK3PXP"
+
+func TestParseKeePass(t *testing.T) {
+	path := writeFile(t, "export.xml", `<?xml version="1.0" encoding="utf-8" standalone="yes"?>
+<KeePassFile>
+	<Meta><RecycleBinUUID>bin</RecycleBinUUID></Meta>
+	<Root>
+		<Group>
+			<UUID>root</UUID>
+			<Name>Database</Name>
+			<Entry>
+				<Tags>work;mail</Tags>
+				<String><Key>Title</Key><Value>Mail</Value></String>
+				<String><Key>UserName</Key><Value>alice</Value></String>
+				<String><Key>Password</Key><Value>secret</Value></String>
+				<String><Key>URL</Key><Value>https://mail.example.com</Value></String>
+				<String><Key>TimeOtp-Secret-Base32</Key><Value>`+totpSecret+`</Value></String>
+				<String><Key>Recovery</Key><Value>code</Value></String>
+			</Entry>
+			<Group>
+				<UUID>bank</UUID>
+				<Name>Finance</Name>
+				<Group>
+					<UUID>cards</UUID>
+					<Name>Cards</Name>
+					<Entry>
+						<String><Key>Title</Key><Value>PIN</Value></String>
+						<String><Key>Notes</Key><Value>1234</Value></String>
+					</Entry>
+				</Group>
+			</Group>
+			<Group>
+				<UUID>bin</UUID>
+				<Name>Recycle Bin</Name>
+				<Entry>
+					<String><Key>Title</Key><Value>Old</Value></String>
+					<String><Key>Password</Key><Value>old</Value></String>
+				</Entry>
+			</Group>
+			<Entry>
+				<String><Key>Title</Key><Value>Empty</Value></String>
+			</Entry>
+		</Group>
+	</Root>
+</KeePassFile>`)
+
+	result, err := Parse(FormatKeePass, path)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(result.Secrets) != 2 {
+		t.Fatalf("secrets = %d, want 2", len(result.Secrets))
+	}
+
+	mail := result.Secrets[0]
+	assertLabels(t, mail, map[string]string{LabelName: "Mail", LabelTagPrefix + "work": "true", LabelTagPrefix + "mail": "true"})
+	login := mail.GetLogin()
+	if login == nil || login.Username != "alice" || string(login.Password) != "secret" {
+		t.Fatalf("login = %v, want alice credentials", login)
+	}
+	if len(login.URIs) != 1 || login.URIs[0] != "https://mail.example.com" {
+		t.Errorf("URIs = %v", login.URIs)
+	}
+	if len(login.TOTPSeed) == 0 {
+		t.Error("TOTP seed is lost")
+	}
+	if string(mail.Data) != "Recovery: code" {
+		t.Errorf("data = %q, want custom field", mail.Data)
+	}
+
+	pin := result.Secrets[1]
+	assertLabels(t, pin, map[string]string{LabelName: "PIN", LabelFolder: "Finance/Cards"})
+	if string(pin.GetNote().GetText()) != "1234" {
+		t.Errorf("note = %q, want 1234", pin.GetNote().GetText())
+	}
+
+	assertSkipped(t, result, "Empty", "Old")
+}
+
+func TestParseBitwarden(t *testing.T) {
+	path := writeFile(t, "export.json", `{
+	"encrypted": false,
+	"folders": [{"id": "f1", "name": "Social"}],
+	"items": [
+		{"type": 1, "name": "Forum", "folderId": "f1", "notes": "old account",
+		 "login": {"username": "bob", "password": "pa55", "totp": "`+totpSecret+`", "uris": [{"uri": "https://forum.example.com"}]}},
+		{"type": 2, "name": "Wifi", "notes": "guest/guest"},
+		{"type": 3, "name": "Visa", "card": {"cardholderName": "BOB", "number": "4111111111111111", "expMonth": "7", "expYear": "2030", "code": "123"}},
+		{"type": 4, "name": "Passport"}
+	]
+}`)
+
+	result, err := Parse(FormatBitwarden, path)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(result.Secrets) != 3 {
+		t.Fatalf("secrets = %d, want 3", len(result.Secrets))
+	}
+
+	forum := result.Secrets[0]
+	assertLabels(t, forum, map[string]string{LabelName: "Forum", LabelFolder: "Social"})
+	if login := forum.GetLogin(); login == nil || login.Username != "bob" || len(login.TOTPSeed) == 0 {
+		t.Errorf("login = %v, want bob with TOTP", login)
+	}
+	if string(forum.Data) != "old account" {
+		t.Errorf("data = %q, want notes", forum.Data)
+	}
+
+	if string(result.Secrets[1].GetNote().GetText()) != "guest/guest" {
+		t.Errorf("note = %q", result.Secrets[1].GetNote().GetText())
+	}
+
+	card := result.Secrets[2].GetCard()
+	if card == nil || card.Holder != "BOB" || card.Expiry != "07/30" || string(card.CVV) != "123" {
+		t.Errorf("card = %v, want BOB 07/30", card)
+	}
+
+	assertSkipped(t, result, "Passport")
+
+	encrypted := writeFile(t, "encrypted.json", `{"encrypted": true, "items": []}`)
+	if _, err := Parse(FormatBitwarden, encrypted); !errors.Is(err, ErrEncryptedExport) {
+		t.Errorf("encrypted export err = %v, want %v", err, ErrEncryptedExport)
+	}
+}
+
+func TestParse1Password(t *testing.T) {
+	path := writeFile(t, "export.csv", "Title,Url,Username,Password,OTPAuth,Favorite,Archived,Tags,Notes\n"+
+		"Shop,https://shop.example.com,carol,hunter2,,false,false,\"home, online shopping\",\n"+
+		"Broken,,dave,pw,not-a-seed!,false,false,,\n"+
+		"Blank,,,,,false,false,,\n")
+
+	result, err := Parse(Format1Password, path)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(result.Secrets) != 1 {
+		t.Fatalf("secrets = %d, want 1", len(result.Secrets))
+	}
+
+	shop := result.Secrets[0]
+	assertLabels(t, shop, map[string]string{LabelName: "Shop", LabelTagPrefix + "home": "true", LabelTagPrefix + "online-shopping": "true"})
+	if login := shop.GetLogin(); login == nil || login.Username != "carol" || string(login.Password) != "hunter2" {
+		t.Errorf("login = %v, want carol credentials", login)
+	}
+
+	assertSkipped(t, result, "Broken", "Blank")
+
+	unknown := writeFile(t, "unknown.csv", "a,b\n1,2\n")
+	if _, err := Parse(Format1Password, unknown); !errors.Is(err, ErrUnknownCSVHeader) {
+		t.Errorf("unknown header err = %v, want %v", err, ErrUnknownCSVHeader)
+	}
+}
+
+func TestParsePass(t *testing.T) {
+	root := t.TempDir()
+	files := map[string]string{
+		".gpg-id":                "alice@example.com\n",
+		".git/config":            "[core]\n",
+		"email/work.gpg":         "s3cret\nlogin: alice\nurl: https://mail.example.com\notpauth://totp/work?secret=" + totpSecret + "\nrecovery codes below\n",
+		"notes/garage":           "\nopen with the left key\n",
+		"encrypted/bank.gpg":     "\x85\x02\x0c\xff\xfe",
+		"empty/nothing-here.gpg": "",
+	}
+	for name, data := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	result, err := Parse(FormatPass, root)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(result.Secrets) != 2 {
+		t.Fatalf("secrets = %d, want 2", len(result.Secrets))
+	}
+
+	work := result.Secrets[0]
+	assertLabels(t, work, map[string]string{LabelName: "work", LabelFolder: "email"})
+	login := work.GetLogin()
+	if login == nil || login.Username != "alice" || string(login.Password) != "s3cret" || len(login.TOTPSeed) == 0 {
+		t.Fatalf("login = %v, want alice credentials with TOTP", login)
+	}
+	if string(work.Data) != "recovery codes below" {
+		t.Errorf("data = %q, want notes", work.Data)
+	}
+
+	garage := result.Secrets[1]
+	assertLabels(t, garage, map[string]string{LabelName: "garage", LabelFolder: "notes"})
+	if string(garage.GetNote().GetText()) != "open with the left key" {
+		t.Errorf("note = %q", garage.GetNote().GetText())
+	}
+
+	assertSkipped(t, result, filepath.Join("empty", "nothing-here.gpg"), filepath.Join("encrypted", "bank.gpg"))
+}
+
+func TestParseUnknownFormat(t *testing.T) {
+	if _, err := Parse("lastpass", t.TempDir()); err == nil {
+		t.Error("unknown format is accepted")
+	}
+}
+
+func writeFile(t *testing.T, name, data string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, []byte(data), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	return path
+}
+
+func assertLabels(t *testing.T, secret *pb.Secret, want map[string]string) {
+	t.Helper()
+
+	if len(secret.Labels) != len(want) {
+		t.Errorf("labels = %v, want %v", secret.Labels, want)
+		return
+	}
+	for key, value := range want {
+		if secret.Labels[key] != value {
+			t.Errorf("labels = %v, want %v", secret.Labels, want)
+			return
+		}
+	}
+}
+
+func assertSkipped(t *testing.T, result *Result, names ...string) {
+	t.Helper()
+
+	if len(result.Skipped) != len(names) {
+		t.Fatalf("skipped = %v, want %v", result.Skipped, names)
+	}
+	for i, name := range names {
+		if result.Skipped[i].Name != name || result.Skipped[i].Reason == "" {
+			t.Errorf("skipped[%d] = %v, want %s with the reason", i, result.Skipped[i], name)
+		}
+	}
+}
@@ -0,0 +1,120 @@
+package importer
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/url"
+	"os"
+	"strings"
+)
+
+// KeePass 2 XML export, groups nest entries and other groups
+type keePassFile struct {
+	Meta struct {
+		RecycleBinUUID string `xml:"RecycleBinUUID"`
+	} `xml:"Meta"`
+	Root struct {
+		Groups []keePassGroup `xml:"Group"`
+	} `xml:"Root"`
+}
+
+type keePassGroup struct {
+	UUID    string         `xml:"UUID"`
+	Name    string         `xml:"Name"`
+	Entries []keePassEntry `xml:"Entry"`
+	Groups  []keePassGroup `xml:"Group"`
+}
+
+type keePassEntry struct {
+	Tags    string `xml:"Tags"`
+	Strings []struct {
+		Key   string `xml:"Key"`
+		Value string `xml:"Value"`
+	} `xml:"String"`
+}
+
+var errRecycleBin = errors.New("entry is in the recycle bin")
+
+// parseKeePass reads KeePass 2 XML export, the top group is the database itself and isn't a folder
+func parseKeePass(path string) (*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var file keePassFile
+	if err := xml.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	for _, root := range file.Root.Groups {
+		addKeePassGroup(result, root, nil, file.Meta.RecycleBinUUID, false)
+	}
+
+	return result, nil
+}
+
+func addKeePassGroup(result *Result, group keePassGroup, folder []string, recycleBin string, deleted bool) {
+	deleted = deleted || (recycleBin != "" && group.UUID == recycleBin)
+
+	for _, keePass := range group.Entries {
+		e := keePassToEntry(keePass, folder)
+		if deleted {
+			result.skip(e.name, errRecycleBin)
+			continue
+		}
+
+		result.add(e)
+	}
+
+	for _, child := range group.Groups {
+		addKeePassGroup(result, child, append(folder[:len(folder):len(folder)], child.Name), recycleBin, deleted)
+	}
+}
+
+func keePassToEntry(keePass keePassEntry, folder []string) *entry {
+	e := &entry{
+		folder: folder,
+		tags:   splitTags(keePass.Tags),
+	}
+
+	totp := url.Values{}
+	for _, field := range keePass.Strings {
+		switch field.Key {
+		case "Title":
+			e.name = field.Value
+		case "UserName":
+			e.username = field.Value
+		case "Password":
+			e.password = field.Value
+		case "URL":
+			if field.Value != "" {
+				e.uris = append(e.uris, field.Value)
+			}
+		case "Notes":
+			e.notes = field.Value
+		case "otp":
+			// KeePassXC keeps otpauth:// URI
+			e.totp = field.Value
+		case "TimeOtp-Secret-Base32":
+			totp.Set("secret", field.Value)
+		case "TimeOtp-Period":
+			totp.Set("period", field.Value)
+		case "TimeOtp-Length":
+			totp.Set("digits", field.Value)
+		case "TimeOtp-Algorithm":
+			// HMAC-SHA-1, HMAC-SHA-256 or HMAC-SHA-512
+			totp.Set("algorithm", strings.ReplaceAll(strings.TrimPrefix(field.Value, "HMAC-"), "-", ""))
+		default:
+			e.fields = append(e.fields, [2]string{field.Key, field.Value})
+		}
+	}
+
+	// KeePass 2.47+ keeps TOTP parameters in separate fields
+	if e.totp == "" && totp.Get("secret") != "" {
+		e.totp = (&url.URL{Scheme: "otpauth", Host: "totp", Path: "/", RawQuery: totp.Encode()}).String()
+	}
+
+	return e
+}
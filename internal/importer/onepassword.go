@@ -0,0 +1,94 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// 1Password CSV columns by lower-cased header names of 1Password 7 and 8 exports
+var onePasswordColumns = map[string]string{
+	"title":             "title",
+	"name":              "title",
+	"url":               "url",
+	"urls":              "url",
+	"website":           "url",
+	"username":          "username",
+	"password":          "password",
+	"otpauth":           "totp",
+	"one-time password": "totp",
+	"notes":             "notes",
+	"notesplain":        "notes",
+	"tags":              "tags",
+}
+
+var ErrUnknownCSVHeader = errors.New("unrecognized 1Password CSV header, title and password columns are required")
+
+// parse1Password reads 1Password CSV export with the header row, unknown columns are ignored
+func parse1Password(path string) (*Result, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	reader := csv.NewReader(file)
+	reader.FieldsPerRecord = -1
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		column, ok := onePasswordColumns[strings.ToLower(strings.TrimSpace(name))]
+		if _, taken := columns[column]; ok && !taken {
+			columns[column] = i
+		}
+	}
+
+	_, hasTitle := columns["title"]
+	_, hasPassword := columns["password"]
+	if !hasTitle || !hasPassword {
+		return nil, ErrUnknownCSVHeader
+	}
+
+	result := &Result{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		value := func(column string) string {
+			i, ok := columns[column]
+			if !ok || i >= len(record) {
+				return ""
+			}
+
+			return record[i]
+		}
+
+		e := &entry{
+			name:     value("title"),
+			tags:     splitTags(value("tags")),
+			username: value("username"),
+			password: value("password"),
+			totp:     value("totp"),
+			notes:    value("notes"),
+		}
+		if uri := strings.TrimSpace(value("url")); uri != "" {
+			e.uris = []string{uri}
+		}
+
+		result.add(e)
+	}
+
+	return result, nil
+}
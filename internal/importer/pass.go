@@ -0,0 +1,115 @@
+package importer
+
+import (
+	"bytes"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+)
+
+const passExtension = ".gpg"
+
+var errPassEncrypted = errors.New("file is encrypted, decrypt the password store tree first")
+
+// parsePass reads the decrypted pass(1) password store tree. The first line of the file is the password,
+// login and url lines of the rest are credentials and the other lines are notes. Hidden files such as
+// .gpg-id and directories such as .git are ignored
+func parsePass(root string) (*Result, error) {
+	result := &Result{}
+
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if path != root && strings.HasPrefix(d.Name(), ".") {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		relative, err := filepath.Rel(root, path)
+		if err != nil {
+			return err
+		}
+
+		name := strings.TrimSuffix(filepath.Base(relative), passExtension)
+
+		var folder []string
+		if dir := filepath.Dir(relative); dir != "." {
+			folder = strings.Split(filepath.ToSlash(dir), folderSeparator)
+		}
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		if !utf8.Valid(data) || bytes.HasPrefix(data, []byte("-----BEGIN PGP MESSAGE-----")) {
+			result.skip(relative, errPassEncrypted)
+			return nil
+		}
+
+		e := passToEntry(string(data))
+		e.name = name
+		e.folder = folder
+
+		secret, err := e.secret()
+		if err != nil {
+			result.skip(relative, err)
+			return nil
+		}
+		result.Secrets = append(result.Secrets, secret)
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func passToEntry(data string) *entry {
+	lines := strings.Split(strings.ReplaceAll(data, "\r\n", "\n"), "\n")
+
+	e := &entry{
+		password: lines[0],
+	}
+
+	var notes []string
+	for _, line := range lines[1:] {
+		if strings.HasPrefix(strings.TrimSpace(line), "otpauth://") {
+			e.totp = strings.TrimSpace(line)
+			continue
+		}
+
+		key, value, found := strings.Cut(line, ":")
+		value = strings.TrimSpace(value)
+		switch strings.ToLower(strings.TrimSpace(key)) {
+		case "login", "username", "user", "email":
+			if found && e.username == "" {
+				e.username = value
+				continue
+			}
+		case "url", "website":
+			if found {
+				e.uris = append(e.uris, value)
+				continue
+			}
+		}
+
+		notes = append(notes, line)
+	}
+	e.notes = strings.Join(notes, "\n")
+
+	return e
+}
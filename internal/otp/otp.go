@@ -0,0 +1,259 @@
+package otp
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base32"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TypeTOTP = "totp"
+	TypeHOTP = "hotp"
+
+	AlgorithmSHA1   = "SHA1"
+	AlgorithmSHA256 = "SHA256"
+	AlgorithmSHA512 = "SHA512"
+
+	DefaultDigits = 6
+	DefaultPeriod = 30
+
+	uriScheme = "otpauth"
+	minDigits = 6
+	maxDigits = 8
+)
+
+var (
+	ErrInvalidURI       = errors.New("invalid otpauth URI")
+	ErrInvalidSecret    = errors.New("invalid base32 OTP secret")
+	ErrInvalidAlgorithm = errors.New("unsupported OTP algorithm, use SHA1, SHA256 or SHA512")
+	ErrInvalidDigits    = errors.New("OTP digits should be from 6 to 8")
+	ErrInvalidPeriod    = errors.New("OTP period should be positive")
+)
+
+// Key holds the one-time password seed and its parameters
+type Key struct {
+	Type      string
+	Label     string
+	Issuer    string
+	Secret    []byte
+	Algorithm string
+	Digits    int
+	// Period is the TOTP time step in seconds
+	Period uint64
+	// Counter is the next HOTP counter value
+	Counter uint64
+}
+
+// NewKey returns TOTP key of the base32 secret with default parameters
+func NewKey(secret string) (*Key, error) {
+	decoded, err := decodeSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Key{
+		Type:      TypeTOTP,
+		Secret:    decoded,
+		Algorithm: AlgorithmSHA1,
+		Digits:    DefaultDigits,
+		Period:    DefaultPeriod,
+	}, nil
+}
+
+// Parse accepts either otpauth:// URI or base32 secret
+func Parse(seed string) (*Key, error) {
+	seed = strings.TrimSpace(seed)
+	if strings.HasPrefix(seed, uriScheme+"://") {
+		return ParseURI(seed)
+	}
+
+	return NewKey(seed)
+}
+
+// ParseURI parses otpauth://TYPE/LABEL?PARAMETERS key URI
+func ParseURI(uri string) (*Key, error) {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != uriScheme {
+		return nil, ErrInvalidURI
+	}
+
+	query := u.Query()
+
+	key, err := NewKey(query.Get("secret"))
+	if err != nil {
+		return nil, err
+	}
+
+	key.Type = strings.ToLower(u.Host)
+	if key.Type != TypeTOTP && key.Type != TypeHOTP {
+		return nil, ErrInvalidURI
+	}
+
+	key.Label = strings.TrimPrefix(u.Path, "/")
+	key.Issuer = query.Get("issuer")
+
+	if algorithm := query.Get("algorithm"); algorithm != "" {
+		key.Algorithm = strings.ToUpper(algorithm)
+	}
+
+	if digits := query.Get("digits"); digits != "" {
+		if key.Digits, err = strconv.Atoi(digits); err != nil {
+			return nil, ErrInvalidDigits
+		}
+	}
+
+	if period := query.Get("period"); period != "" {
+		if key.Period, err = strconv.ParseUint(period, 10, 64); err != nil {
+			return nil, ErrInvalidPeriod
+		}
+	}
+
+	if counter := query.Get("counter"); counter != "" {
+		if key.Counter, err = strconv.ParseUint(counter, 10, 64); err != nil {
+			return nil, ErrInvalidURI
+		}
+	}
+
+	if err := key.Validate(); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// Validate checks the key parameters
+func (k *Key) Validate() error {
+	if _, err := hashFunc(k.Algorithm); err != nil {
+		return err
+	}
+
+	if k.Digits < minDigits || k.Digits > maxDigits {
+		return ErrInvalidDigits
+	}
+
+	if k.Type == TypeTOTP && k.Period == 0 {
+		return ErrInvalidPeriod
+	}
+
+	return nil
+}
+
+// URI returns otpauth:// URI of the key
+func (k *Key) URI() string {
+	query := url.Values{}
+	query.Set("secret", base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(k.Secret))
+	query.Set("algorithm", k.Algorithm)
+	query.Set("digits", strconv.Itoa(k.Digits))
+
+	if k.Issuer != "" {
+		query.Set("issuer", k.Issuer)
+	}
+
+	if k.Type == TypeHOTP {
+		query.Set("counter", strconv.FormatUint(k.Counter, 10))
+	} else {
+		query.Set("period", strconv.FormatUint(k.Period, 10))
+	}
+
+	u := url.URL{
+		Scheme:   uriScheme,
+		Host:     k.Type,
+		Path:     "/" + k.Label,
+		RawQuery: query.Encode(),
+	}
+
+	return u.String()
+}
+
+// Code returns the current TOTP code and the time it stays valid,
+// or the HOTP code of the current counter
+func (k *Key) Code(now time.Time) (string, time.Duration, error) {
+	if k.Type == TypeHOTP {
+		code, err := HOTP(k.Secret, k.Counter, k.Digits, k.Algorithm)
+		return code, 0, err
+	}
+
+	return TOTP(k.Secret, now, k.Period, k.Digits, k.Algorithm)
+}
+
+// TOTP computes time-based one-time password, RFC 6238
+func TOTP(secret []byte, now time.Time, period uint64, digits int, algorithm string) (string, time.Duration, error) {
+	if period == 0 {
+		return "", 0, ErrInvalidPeriod
+	}
+
+	unix := uint64(now.Unix())
+	remaining := time.Duration(period-unix%period) * time.Second
+
+	code, err := HOTP(secret, unix/period, digits, algorithm)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return code, remaining, nil
+}
+
+// HOTP computes HMAC-based one-time password, RFC 4226
+func HOTP(secret []byte, counter uint64, digits int, algorithm string) (string, error) {
+	newHash, err := hashFunc(algorithm)
+	if err != nil {
+		return "", err
+	}
+
+	if digits < minDigits || digits > maxDigits {
+		return "", ErrInvalidDigits
+	}
+
+	var message [8]byte
+	binary.BigEndian.PutUint64(message[:], counter)
+
+	mac := hmac.New(newHash, secret)
+	mac.Write(message[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation
+	offset := sum[len(sum)-1] & 0x0f
+	binCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint32(1)
+	for i := 0; i < digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", digits, binCode%modulo), nil
+}
+
+func hashFunc(algorithm string) (func() hash.Hash, error) {
+	switch algorithm {
+	case AlgorithmSHA1:
+		return sha1.New, nil
+	case AlgorithmSHA256:
+		return sha256.New, nil
+	case AlgorithmSHA512:
+		return sha512.New, nil
+	default:
+		return nil, ErrInvalidAlgorithm
+	}
+}
+
+func decodeSecret(secret string) ([]byte, error) {
+	secret = strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	secret = strings.TrimRight(secret, "=")
+
+	decoded, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil || len(decoded) == 0 {
+		return nil, ErrInvalidSecret
+	}
+
+	return decoded, nil
+}
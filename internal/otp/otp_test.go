@@ -0,0 +1,117 @@
+package otp
+
+import (
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B test vectors
+func TestTOTP(t *testing.T) {
+	secrets := map[string][]byte{
+		AlgorithmSHA1:   []byte("12345678901234567890"),
+		AlgorithmSHA256: []byte("12345678901234567890123456789012"),
+		AlgorithmSHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+
+	tests := []struct {
+		unix      int64
+		algorithm string
+		code      string
+	}{
+		{59, AlgorithmSHA1, "94287082"},
+		{59, AlgorithmSHA256, "46119246"},
+		{59, AlgorithmSHA512, "90693936"},
+		{1111111109, AlgorithmSHA1, "07081804"},
+		{1111111109, AlgorithmSHA256, "68084774"},
+		{1111111109, AlgorithmSHA512, "25091201"},
+		{1111111111, AlgorithmSHA1, "14050471"},
+		{1111111111, AlgorithmSHA256, "67062674"},
+		{1111111111, AlgorithmSHA512, "99943326"},
+		{1234567890, AlgorithmSHA1, "89005924"},
+		{1234567890, AlgorithmSHA256, "91819424"},
+		{1234567890, AlgorithmSHA512, "93441116"},
+		{2000000000, AlgorithmSHA1, "69279037"},
+		{2000000000, AlgorithmSHA256, "90698825"},
+		{2000000000, AlgorithmSHA512, "38618901"},
+		{20000000000, AlgorithmSHA1, "65353130"},
+		{20000000000, AlgorithmSHA256, "77737706"},
+		{20000000000, AlgorithmSHA512, "47863826"},
+	}
+
+	for _, tt := range tests {
+		code, remaining, err := TOTP(secrets[tt.algorithm], time.Unix(tt.unix, 0), DefaultPeriod, 8, tt.algorithm)
+		if err != nil {
+			t.Fatalf("TOTP(%d, %s): %v", tt.unix, tt.algorithm, err)
+		}
+		if code != tt.code {
+			t.Errorf("TOTP(%d, %s) = %s, want %s", tt.unix, tt.algorithm, code, tt.code)
+		}
+		if want := time.Duration(DefaultPeriod-tt.unix%DefaultPeriod) * time.Second; remaining != want {
+			t.Errorf("TOTP(%d, %s) remaining = %s, want %s", tt.unix, tt.algorithm, remaining, want)
+		}
+	}
+}
+
+// RFC 4226 Appendix D test vectors
+func TestHOTP(t *testing.T) {
+	secret := []byte("12345678901234567890")
+	codes := []string{
+		"755224", "287082", "359152", "969429", "338314",
+		"254676", "287922", "162583", "399871", "520489",
+	}
+
+	for counter, want := range codes {
+		code, err := HOTP(secret, uint64(counter), DefaultDigits, AlgorithmSHA1)
+		if err != nil {
+			t.Fatalf("HOTP(%d): %v", counter, err)
+		}
+		if code != want {
+			t.Errorf("HOTP(%d) = %s, want %s", counter, code, want)
+		}
+	}
+}
+
+func TestParse(t *testing.T) {
+	// "12345678901234567890" in base32
+	const secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	key, err := Parse("otpauth://totp/ACME:john@example.com?secret=" + secret + "&issuer=ACME&algorithm=SHA256&digits=8&period=60")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Type != TypeTOTP || key.Algorithm != AlgorithmSHA256 || key.Digits != 8 || key.Period != 60 {
+		t.Errorf("unexpected key parameters %+v", key)
+	}
+	if string(key.Secret) != "12345678901234567890" {
+		t.Errorf("unexpected secret %q", key.Secret)
+	}
+
+	reparsed, err := Parse(key.URI())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reparsed.URI() != key.URI() {
+		t.Errorf("URI round trip mismatch: %s != %s", reparsed.URI(), key.URI())
+	}
+
+	key, err = Parse(" gezd gnbv gy3t qojq gezd gnbv gy3t qojq ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key.Type != TypeTOTP || key.Algorithm != AlgorithmSHA1 || key.Digits != DefaultDigits || key.Period != DefaultPeriod {
+		t.Errorf("unexpected default key parameters %+v", key)
+	}
+
+	for _, seed := range []string{
+		"",
+		"not base32!",
+		"otpauth://totp/x?secret=" + secret + "&algorithm=MD5",
+		"otpauth://totp/x?secret=" + secret + "&digits=4",
+		"otpauth://sotp/x?secret=" + secret,
+		"https://totp/x?secret=" + secret,
+	} {
+		if _, err := Parse(seed); err == nil {
+			t.Errorf("Parse(%q) expected error", seed)
+		}
+	}
+}
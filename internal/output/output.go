@@ -0,0 +1,227 @@
+// Package output prints command results as a table, JSON, YAML, environment variables or a Go template.
+// Field names of JSON, YAML and environment variables are stable, templates use names of Go struct fields
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"text/template"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FormatTable    = "table"
+	FormatJSON     = "json"
+	FormatYAML     = "yaml"
+	FormatEnv      = "env"
+	FormatTemplate = "template"
+)
+
+var (
+	ErrUnknownFormat = errors.New("unknown output format, use table, json, yaml, env or template=<template>")
+
+	envUnsafe = regexp.MustCompile(`[^A-Z0-9_]`)
+)
+
+// Printer prints results in the format selected by the output flag
+type Printer struct {
+	format   string
+	template *template.Template
+}
+
+// New returns printer of the format: table, json, yaml, env or template=<Go template>, the empty format is table
+func New(format string) (*Printer, error) {
+	name, text, _ := strings.Cut(format, "=")
+
+	switch name {
+	case "":
+		return &Printer{format: FormatTable}, nil
+	case FormatTable, FormatJSON, FormatYAML, FormatEnv:
+		if text != "" {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+		}
+
+		return &Printer{format: name}, nil
+	case FormatTemplate:
+		tmpl, err := template.New(FormatTemplate).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return nil, err
+		}
+
+		return &Printer{format: name, template: tmpl}, nil
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// Format returns the name of the printer format
+func (p *Printer) Format() string {
+	return p.format
+}
+
+// Print prints the value, the table function renders the value in the table format only
+func (p *Printer) Print(w io.Writer, value interface{}, table func(w io.Writer) error) error {
+	switch p.format {
+	case FormatJSON:
+		encoder := json.NewEncoder(w)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+
+		return encoder.Encode(value)
+	case FormatYAML:
+		encoder := yaml.NewEncoder(w)
+		encoder.SetIndent(2)
+		if err := encoder.Encode(value); err != nil {
+			return err
+		}
+
+		return encoder.Close()
+	case FormatEnv:
+		return printEnv(w, value)
+	case FormatTemplate:
+		return p.printTemplate(w, value)
+	default:
+		return table(w)
+	}
+}
+
+// printTemplate executes the template for the value or for every element of the list, one per line
+func (p *Printer) printTemplate(w io.Writer, value interface{}) error {
+	items := []interface{}{value}
+	if v := reflect.ValueOf(value); v.Kind() == reflect.Slice {
+		items = make([]interface{}, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, v.Index(i).Interface())
+		}
+	}
+
+	for _, item := range items {
+		var buf bytes.Buffer
+		if err := p.template.Execute(&buf, item); err != nil {
+			return err
+		}
+
+		if !bytes.HasSuffix(buf.Bytes(), []byte("\n")) {
+			buf.WriteByte('\n')
+		}
+
+		if _, err := w.Write(buf.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// printEnv prints the value flattened to shell-quoted KEY=value lines, the keys are JSON field names
+// joined with underscores, e.g. LABELS_ENV, elements of lists are prefixed with their index, e.g. ITEM_0_ID
+func printEnv(w io.Writer, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var generic interface{}
+	if err := decoder.Decode(&generic); err != nil {
+		return err
+	}
+
+	prefix := ""
+	if _, ok := generic.([]interface{}); ok {
+		prefix = "ITEM"
+	}
+
+	vars := make(map[string]string)
+	flatten(vars, prefix, generic)
+
+	keys := make([]string, 0, len(vars))
+	for key := range vars {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if _, err := fmt.Fprintf(w, "%s=%s\n", key, shellQuote(vars[key])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func flatten(vars map[string]string, key string, value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for name, field := range v {
+			flatten(vars, envKey(key, name), field)
+		}
+	case []interface{}:
+		for i, element := range v {
+			flatten(vars, envKey(key, fmt.Sprint(i)), element)
+		}
+	case nil:
+	default:
+		vars[key] = fmt.Sprint(v)
+	}
+}
+
+func envKey(prefix, name string) string {
+	name = envUnsafe.ReplaceAllString(strings.ToUpper(name), "_")
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "_" + name
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// WriteTable writes the table with columns separated by vertical bars, the header is omitted if it's nil
+func WriteTable(w io.Writer, header []string, rows [][]string) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 0, ' ', tabwriter.Escape)
+
+	if header != nil {
+		rows = append([][]string{header}, rows...)
+	}
+
+	for _, row := range rows {
+		cells := make([]interface{}, 0, 2*len(row))
+		for i, cell := range row {
+			if i > 0 {
+				cells = append(cells, "\t")
+			}
+			cells = append(cells, cell)
+		}
+
+		if _, err := fmt.Fprintln(tw, cells...); err != nil {
+			return err
+		}
+	}
+
+	return tw.Flush()
+}
+
+// Time formats the timestamp as RFC 3339 in UTC, the empty string is returned for the unset timestamp
+func Time(t *timestamppb.Timestamp) string {
+	if t == nil {
+		return ""
+	}
+
+	return t.AsTime().UTC().Format(time.RFC3339Nano)
+}
@@ -0,0 +1,150 @@
+package output
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+func testSecret() *pb.Secret {
+	return &pb.Secret{
+		ID:        "login",
+		Labels:    map[string]string{"env": "prod", "example.com/team": "web"},
+		CreatedAt: timestamppb.New(time.Date(2020, 9, 13, 12, 26, 40, 0, time.UTC)),
+		Status:    &pb.Status{Synced: true},
+		Payload: &pb.Secret_Login{Login: &pb.LoginPayload{
+			Username: "alice",
+			Password: []byte("it's secret"),
+			URIs:     []string{"https://example.com"},
+		}},
+	}
+}
+
+func printValue(t *testing.T, format string, value interface{}) string {
+	t.Helper()
+
+	printer, err := New(format)
+	if err != nil {
+		t.Fatalf("New(%q) error = %v", format, err)
+	}
+
+	var buf bytes.Buffer
+	err = printer.Print(&buf, value, func(w io.Writer) error {
+		return WriteTable(w, []string{"ID", "Type"}, [][]string{{"login", "login"}})
+	})
+	if err != nil {
+		t.Fatalf("Print(%q) error = %v", format, err)
+	}
+
+	return buf.String()
+}
+
+func TestPrint(t *testing.T) {
+	secret := NewDecryptedSecret(testSecret())
+
+	tests := []struct {
+		format string
+		value  interface{}
+		want   string
+	}{
+		{"", secret, "   ID | Type\nlogin | login\n"},
+		{"table", secret, "   ID | Type\nlogin | login\n"},
+		{"json", NewSecret(testSecret()), `{
+  "id": "login",
+  "type": "login",
+  "labels": {
+    "env": "prod",
+    "example.com/team": "web"
+  },
+  "created_at": "2020-09-13T12:26:40Z",
+  "deleted": false,
+  "synced": true
+}
+`},
+		{"yaml", NewSecret(testSecret()), `id: login
+type: login
+labels:
+  env: prod
+  example.com/team: web
+created_at: "2020-09-13T12:26:40Z"
+deleted: false
+synced: true
+`},
+		{"env", secret, `CREATED_AT='2020-09-13T12:26:40Z'
+DELETED='false'
+ID='login'
+LABELS_ENV='prod'
+LABELS_EXAMPLE_COM_TEAM='web'
+LOGIN_PASSWORD='it'\''s secret'
+LOGIN_TOTP='false'
+LOGIN_URIS_0='https://example.com'
+LOGIN_USERNAME='alice'
+SYNCED='true'
+TYPE='login'
+`},
+		{"env", []Secret{NewSecret(testSecret())}, `ITEM_0_CREATED_AT='2020-09-13T12:26:40Z'
+ITEM_0_DELETED='false'
+ITEM_0_ID='login'
+ITEM_0_LABELS_ENV='prod'
+ITEM_0_LABELS_EXAMPLE_COM_TEAM='web'
+ITEM_0_SYNCED='true'
+ITEM_0_TYPE='login'
+`},
+		{"template={{.ID}} {{.Login.Username}}", secret, "login alice\n"},
+		{"template={{.ID}}", []Secret{secret, secret}, "login\nlogin\n"},
+		{`template={{index .Labels "env"}}{{"\n"}}`, secret, "prod\n"},
+	}
+
+	for _, tt := range tests {
+		if got := printValue(t, tt.format, tt.value); got != tt.want {
+			t.Errorf("Print(%q) = %q, want %q", tt.format, got, tt.want)
+		}
+	}
+
+	for _, invalid := range []string{"xml", "json=x", "template={{.ID"} {
+		if _, err := New(invalid); err == nil {
+			t.Errorf("New(%q) succeeded, want error", invalid)
+		}
+	}
+
+	printer, err := New("template={{.Missing}}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := printer.Print(io.Discard, secret, nil); err == nil {
+		t.Error("Print() of missing field succeeded, want error")
+	}
+}
+
+func TestField(t *testing.T) {
+	secret := testSecret()
+
+	for name, want := range map[string]string{
+		"id":         "login",
+		"password":   "it's secret",
+		"username":   "alice",
+		"uris":       "https://example.com",
+		"labels.env": "prod",
+	} {
+		got, err := Field(secret, name)
+		if err != nil {
+			t.Errorf("Field(%q) error = %v", name, err)
+			continue
+		}
+		if string(got) != want {
+			t.Errorf("Field(%q) = %q, want %q", name, got, want)
+		}
+	}
+
+	for _, name := range []string{"number", "labels.missing", "Password"} {
+		if _, err := Field(secret, name); !errors.Is(err, ErrUnknownField) {
+			t.Errorf("Field(%q) error = %v, want %v", name, err, ErrUnknownField)
+		}
+	}
+}
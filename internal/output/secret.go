@@ -0,0 +1,242 @@
+package output
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var ErrUnknownField = errors.New("unknown secret field")
+
+// Secret is the stable view of the secret, the data and the payload are set for decrypted secrets only
+type Secret struct {
+	ID        string            `json:"id" yaml:"id"`
+	Type      string            `json:"type" yaml:"type"`
+	Labels    map[string]string `json:"labels,omitempty" yaml:"labels,omitempty"`
+	CreatedAt string            `json:"created_at,omitempty" yaml:"created_at,omitempty"`
+	UpdatedAt string            `json:"updated_at,omitempty" yaml:"updated_at,omitempty"`
+	DeletedAt string            `json:"deleted_at,omitempty" yaml:"deleted_at,omitempty"`
+	Deleted   bool              `json:"deleted" yaml:"deleted"`
+	Synced    bool              `json:"synced" yaml:"synced"`
+	Data      string            `json:"data,omitempty" yaml:"data,omitempty"`
+	Login     *Login            `json:"login,omitempty" yaml:"login,omitempty"`
+	Card      *Card             `json:"card,omitempty" yaml:"card,omitempty"`
+	Note      *Note             `json:"note,omitempty" yaml:"note,omitempty"`
+	SSHKey    *SSHKey           `json:"ssh_key,omitempty" yaml:"ssh_key,omitempty"`
+	File      *File             `json:"file,omitempty" yaml:"file,omitempty"`
+}
+
+// Login is the login payload, the TOTP seed never leaves the agent, so only its presence is reported
+type Login struct {
+	Username string   `json:"username" yaml:"username"`
+	Password string   `json:"password" yaml:"password"`
+	URIs     []string `json:"uris,omitempty" yaml:"uris,omitempty"`
+	TOTP     bool     `json:"totp" yaml:"totp"`
+}
+
+type Card struct {
+	Holder string `json:"holder" yaml:"holder"`
+	Number string `json:"number" yaml:"number"`
+	Expiry string `json:"expiry" yaml:"expiry"`
+	CVV    string `json:"cvv" yaml:"cvv"`
+}
+
+type Note struct {
+	Text string `json:"text" yaml:"text"`
+}
+
+// SSHKey is the SSH key payload, the private key is base64 encoded
+type SSHKey struct {
+	PublicKey  string `json:"public_key" yaml:"public_key"`
+	PrivateKey string `json:"private_key" yaml:"private_key"`
+}
+
+// File is the file payload without the content, use the content field to get it
+type File struct {
+	Name     string `json:"name" yaml:"name"`
+	MIMEType string `json:"mime_type" yaml:"mime_type"`
+	Size     int    `json:"size" yaml:"size"`
+}
+
+// Version is the prior version of the secret
+type Version struct {
+	Version    uint64 `json:"version" yaml:"version"`
+	ArchivedAt string `json:"archived_at" yaml:"archived_at"`
+	Secret     Secret `json:"secret" yaml:"secret"`
+}
+
+// Conflict is the secret changed both locally and on the server
+type Conflict struct {
+	ID         string `json:"id" yaml:"id"`
+	Type       string `json:"type" yaml:"type"`
+	Local      Secret `json:"local" yaml:"local"`
+	Remote     Secret `json:"remote" yaml:"remote"`
+	DetectedAt string `json:"detected_at" yaml:"detected_at"`
+}
+
+// NewSecret returns the view of the secret metadata, the sealed data and payload are omitted
+func NewSecret(secret *pb.Secret) Secret {
+	return Secret{
+		ID:        secret.GetID(),
+		Type:      payload.Type(secret),
+		Labels:    secret.GetLabels(),
+		CreatedAt: Time(secret.GetCreatedAt()),
+		UpdatedAt: Time(secret.GetUpdatedAt()),
+		DeletedAt: Time(secret.GetDeletedAt()),
+		Deleted:   secret.GetStatus().GetDeleted(),
+		Synced:    secret.GetStatus().GetSynced(),
+	}
+}
+
+// NewSecrets returns views of the secrets metadata
+func NewSecrets(secrets []*pb.Secret) []Secret {
+	views := make([]Secret, 0, len(secrets))
+	for _, secret := range secrets {
+		views = append(views, NewSecret(secret))
+	}
+
+	return views
+}
+
+// NewDecryptedSecret returns the view of the decrypted secret with the data and the payload
+func NewDecryptedSecret(secret *pb.Secret) Secret {
+	view := NewSecret(secret)
+	view.Data = string(secret.GetData())
+
+	if login := secret.GetLogin(); login != nil {
+		view.Login = &Login{
+			Username: login.GetUsername(),
+			Password: string(login.GetPassword()),
+			URIs:     login.GetURIs(),
+			TOTP:     len(login.GetTOTPSeed()) > 0,
+		}
+	}
+
+	if card := secret.GetCard(); card != nil {
+		view.Card = &Card{
+			Holder: card.GetHolder(),
+			Number: string(card.GetNumber()),
+			Expiry: card.GetExpiry(),
+			CVV:    string(card.GetCVV()),
+		}
+	}
+
+	if note := secret.GetNote(); note != nil {
+		view.Note = &Note{Text: string(note.GetText())}
+	}
+
+	if sshKey := secret.GetSshKey(); sshKey != nil {
+		view.SSHKey = &SSHKey{
+			PublicKey:  strings.TrimSpace(string(sshKey.GetPublicKey())),
+			PrivateKey: encryption.ToBase64(sshKey.GetPrivateKey()),
+		}
+	}
+
+	if file := secret.GetFile(); file != nil {
+		view.File = &File{
+			Name:     file.GetName(),
+			MIMEType: file.GetMIMEType(),
+			Size:     len(file.GetContent()),
+		}
+	}
+
+	return view
+}
+
+// NewVersions returns views of prior versions of the secret
+func NewVersions(versions []*pb.SecretVersion) []Version {
+	views := make([]Version, 0, len(versions))
+	for _, version := range versions {
+		views = append(views, Version{
+			Version:    version.GetVersion(),
+			ArchivedAt: Time(version.GetArchivedAt()),
+			Secret:     NewSecret(version.GetSecret()),
+		})
+	}
+
+	return views
+}
+
+// NewConflicts returns views of the secret conflicts
+func NewConflicts(conflicts []*pb.SecretConflict) []Conflict {
+	views := make([]Conflict, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		views = append(views, Conflict{
+			ID:         conflict.GetLocal().GetID(),
+			Type:       payload.Type(conflict.GetLocal()),
+			Local:      NewSecret(conflict.GetLocal()),
+			Remote:     NewSecret(conflict.GetRemote()),
+			DetectedAt: Time(conflict.GetDetectedAt()),
+		})
+	}
+
+	return views
+}
+
+// Field returns the raw value of the decrypted secret field named as in JSON, e.g. password or labels.env.
+// Binary values like the SSH private key or the file content are returned as is
+func Field(secret *pb.Secret, name string) ([]byte, error) {
+	if strings.HasPrefix(name, "labels.") {
+		key := strings.TrimPrefix(name, "labels.")
+		value, ok := secret.GetLabels()[key]
+		if !ok {
+			return nil, fmt.Errorf("%w %q: the secret has no label %q", ErrUnknownField, name, key)
+		}
+
+		return []byte(value), nil
+	}
+
+	fields := secretFields(secret)
+
+	value, ok := fields[name]
+	if !ok {
+		names := make([]string, 0, len(fields))
+		for field := range fields {
+			names = append(names, field)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("%w %q of %s secret, use one of: %s, labels.<key>",
+			ErrUnknownField, name, payload.Type(secret), strings.Join(names, ", "))
+	}
+
+	return value, nil
+}
+
+func secretFields(secret *pb.Secret) map[string][]byte {
+	fields := map[string][]byte{
+		"id":   []byte(secret.GetID()),
+		"type": []byte(payload.Type(secret)),
+		"data": secret.GetData(),
+	}
+
+	switch payload.Type(secret) {
+	case payload.TypeLogin:
+		login := secret.GetLogin()
+		fields["username"] = []byte(login.GetUsername())
+		fields["password"] = login.GetPassword()
+		fields["uris"] = []byte(strings.Join(login.GetURIs(), "\n"))
+	case payload.TypeCard:
+		card := secret.GetCard()
+		fields["holder"] = []byte(card.GetHolder())
+		fields["number"] = card.GetNumber()
+		fields["expiry"] = []byte(card.GetExpiry())
+		fields["cvv"] = card.GetCVV()
+	case payload.TypeNote:
+		fields["text"] = secret.GetNote().GetText()
+	case payload.TypeSSHKey:
+		fields["public_key"] = secret.GetSshKey().GetPublicKey()
+		fields["private_key"] = secret.GetSshKey().GetPrivateKey()
+	case payload.TypeFile:
+		fields["name"] = []byte(secret.GetFile().GetName())
+		fields["mime_type"] = []byte(secret.GetFile().GetMIMEType())
+		fields["content"] = secret.GetFile().GetContent()
+	}
+
+	return fields
+}
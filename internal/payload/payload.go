@@ -0,0 +1,174 @@
+package payload
+
+import (
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// MaxFileSize limits the content of the file secret, so the sealed secret fits the 4 MiB message
+// of the sync with the server
+const MaxFileSize = 3 << 20
+
+// Secret types as shown to the user
+const (
+	TypeData   = "data"
+	TypeLogin  = "login"
+	TypeCard   = "card"
+	TypeNote   = "note"
+	TypeSSHKey = "ssh"
+	TypeFile   = "file"
+)
+
+// Type returns the type of the secret payload, secrets without payload carry opaque data
+func Type(secret *pb.Secret) string {
+	switch secret.GetPayload().(type) {
+	case *pb.Secret_Login:
+		return TypeLogin
+	case *pb.Secret_Card:
+		return TypeCard
+	case *pb.Secret_Note:
+		return TypeNote
+	case *pb.Secret_SshKey:
+		return TypeSSHKey
+	case *pb.Secret_File:
+		return TypeFile
+	default:
+		return TypeData
+	}
+}
+
+// FileTooLarge reports whether the content of the file secret exceeds MaxFileSize
+func FileTooLarge(secret *pb.Secret) bool {
+	return len(secret.GetFile().GetContent()) > MaxFileSize
+}
+
+// Content returns the main content of the secret: the password of the login, the number of the card,
+// the text of the note, the private SSH key, the content of the file or the data of the untyped secret
+func Content(secret *pb.Secret) []byte {
+	switch Type(secret) {
+	case TypeLogin:
+		return secret.GetLogin().GetPassword()
+	case TypeCard:
+		return secret.GetCard().GetNumber()
+	case TypeNote:
+		return secret.GetNote().GetText()
+	case TypeSSHKey:
+		return secret.GetSshKey().GetPrivateKey()
+	case TypeFile:
+		return secret.GetFile().GetContent()
+	default:
+		return secret.GetData()
+	}
+}
+
+// SetContent replaces the main content of the secret returned by Content, other fields are kept
+func SetContent(secret *pb.Secret, content []byte) {
+	switch Type(secret) {
+	case TypeLogin:
+		secret.GetLogin().Password = content
+	case TypeCard:
+		secret.GetCard().Number = content
+	case TypeNote:
+		secret.GetNote().Text = content
+	case TypeSSHKey:
+		secret.GetSshKey().PrivateKey = content
+	case TypeFile:
+		secret.GetFile().Content = content
+	default:
+		secret.Data = content
+	}
+}
+
+// Marshal serializes the secret payload for storage, secrets without payload give nil
+func Marshal(secret *pb.Secret) ([]byte, error) {
+	if secret.GetPayload() == nil {
+		return nil, nil
+	}
+
+	return proto.Marshal(&pb.Secret{Payload: secret.GetPayload()})
+}
+
+// Unmarshal restores the secret payload serialized with Marshal
+func Unmarshal(data []byte, secret *pb.Secret) error {
+	if len(data) == 0 {
+		return nil
+	}
+
+	stored := &pb.Secret{}
+	if err := proto.Unmarshal(data, stored); err != nil {
+		return err
+	}
+
+	secret.Payload = stored.GetPayload()
+
+	return nil
+}
+
+// Seal encrypts sensitive fields of the secret payload in place
+func Seal(secret *pb.Secret, encrypt func([]byte) ([]byte, error)) error {
+	return transform(secret, encrypt, true)
+}
+
+// Open decrypts sensitive fields of the secret payload sealed with Seal in place.
+// One-time password seeds stay sealed, codes are computed by the agent.
+func Open(secret *pb.Secret, decrypt func([]byte) ([]byte, error)) error {
+	return transform(secret, decrypt, false)
+}
+
+// OpenAll decrypts all sensitive fields of the secret payload including one-time password seeds in place,
+// e.g. to export the secret
+func OpenAll(secret *pb.Secret, decrypt func([]byte) ([]byte, error)) error {
+	return transform(secret, decrypt, true)
+}
+
+// OpenOTPSeed returns the decrypted one-time password seed of the login, nil if there is none
+func OpenOTPSeed(secret *pb.Secret, decrypt func([]byte) ([]byte, error)) ([]byte, error) {
+	seed := secret.GetLogin().GetTOTPSeed()
+	if len(seed) == 0 {
+		return nil, nil
+	}
+
+	return decrypt(seed)
+}
+
+func transform(secret *pb.Secret, fn func([]byte) ([]byte, error), withOTPSeed bool) error {
+	fields := sensitiveFields(secret)
+	if login := secret.GetLogin(); login != nil && withOTPSeed {
+		fields = append(fields, &login.TOTPSeed)
+	}
+
+	for _, field := range fields {
+		if len(*field) == 0 {
+			continue
+		}
+
+		value, err := fn(*field)
+		if err != nil {
+			return err
+		}
+
+		*field = value
+	}
+
+	return nil
+}
+
+func sensitiveFields(secret *pb.Secret) []*[]byte {
+	switch {
+	case secret.GetLogin() != nil:
+		login := secret.GetLogin()
+		return []*[]byte{&login.Password}
+	case secret.GetCard() != nil:
+		card := secret.GetCard()
+		return []*[]byte{&card.Number, &card.CVV}
+	case secret.GetNote() != nil:
+		return []*[]byte{&secret.GetNote().Text}
+	case secret.GetSshKey() != nil:
+		return []*[]byte{&secret.GetSshKey().PrivateKey}
+	case secret.GetFile() != nil:
+		return []*[]byte{&secret.GetFile().Content}
+	default:
+		return nil
+	}
+}
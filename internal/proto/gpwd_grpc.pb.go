@@ -23,10 +23,19 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SecretsClient interface {
 	CreateSecret(ctx context.Context, in *CreateSecretRequest, opts ...grpc.CallOption) (*CreateSecretResponse, error)
+	CreateSecrets(ctx context.Context, in *CreateSecretsRequest, opts ...grpc.CallOption) (*CreateSecretsResponse, error)
 	ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error)
 	GetSecret(ctx context.Context, in *GetSecretRequest, opts ...grpc.CallOption) (*GetSecretResponse, error)
+	GetSecretOTP(ctx context.Context, in *GetSecretOTPRequest, opts ...grpc.CallOption) (*GetSecretOTPResponse, error)
 	UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error)
 	DeleteSecret(ctx context.Context, in *DeleteSecretRequest, opts ...grpc.CallOption) (*DeleteSecretResponse, error)
+	UndeleteSecret(ctx context.Context, in *UndeleteSecretRequest, opts ...grpc.CallOption) (*UndeleteSecretResponse, error)
+	ListSecretVersions(ctx context.Context, in *ListSecretVersionsRequest, opts ...grpc.CallOption) (*ListSecretVersionsResponse, error)
+	RestoreSecretVersion(ctx context.Context, in *RestoreSecretVersionRequest, opts ...grpc.CallOption) (*RestoreSecretVersionResponse, error)
+	ListSecretConflicts(ctx context.Context, in *ListSecretConflictsRequest, opts ...grpc.CallOption) (*ListSecretConflictsResponse, error)
+	ResolveSecretConflict(ctx context.Context, in *ResolveSecretConflictRequest, opts ...grpc.CallOption) (*ResolveSecretConflictResponse, error)
+	ExportSecrets(ctx context.Context, in *ExportSecretsRequest, opts ...grpc.CallOption) (*ExportSecretsResponse, error)
+	RestoreSecrets(ctx context.Context, in *RestoreSecretsRequest, opts ...grpc.CallOption) (*RestoreSecretsResponse, error)
 }
 
 type secretsClient struct {
@@ -46,6 +55,15 @@ func (c *secretsClient) CreateSecret(ctx context.Context, in *CreateSecretReques
 	return out, nil
 }
 
+func (c *secretsClient) CreateSecrets(ctx context.Context, in *CreateSecretsRequest, opts ...grpc.CallOption) (*CreateSecretsResponse, error) {
+	out := new(CreateSecretsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/CreateSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *secretsClient) ListSecrets(ctx context.Context, in *ListSecretsRequest, opts ...grpc.CallOption) (*ListSecretsResponse, error) {
 	out := new(ListSecretsResponse)
 	err := c.cc.Invoke(ctx, "/proto.Secrets/ListSecrets", in, out, opts...)
@@ -64,6 +82,15 @@ func (c *secretsClient) GetSecret(ctx context.Context, in *GetSecretRequest, opt
 	return out, nil
 }
 
+func (c *secretsClient) GetSecretOTP(ctx context.Context, in *GetSecretOTPRequest, opts ...grpc.CallOption) (*GetSecretOTPResponse, error) {
+	out := new(GetSecretOTPResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/GetSecretOTP", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *secretsClient) UpdateSecret(ctx context.Context, in *UpdateSecretRequest, opts ...grpc.CallOption) (*UpdateSecretResponse, error) {
 	out := new(UpdateSecretResponse)
 	err := c.cc.Invoke(ctx, "/proto.Secrets/UpdateSecret", in, out, opts...)
@@ -82,15 +109,87 @@ func (c *secretsClient) DeleteSecret(ctx context.Context, in *DeleteSecretReques
 	return out, nil
 }
 
+func (c *secretsClient) UndeleteSecret(ctx context.Context, in *UndeleteSecretRequest, opts ...grpc.CallOption) (*UndeleteSecretResponse, error) {
+	out := new(UndeleteSecretResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/UndeleteSecret", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListSecretVersions(ctx context.Context, in *ListSecretVersionsRequest, opts ...grpc.CallOption) (*ListSecretVersionsResponse, error) {
+	out := new(ListSecretVersionsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/ListSecretVersions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RestoreSecretVersion(ctx context.Context, in *RestoreSecretVersionRequest, opts ...grpc.CallOption) (*RestoreSecretVersionResponse, error) {
+	out := new(RestoreSecretVersionResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/RestoreSecretVersion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ListSecretConflicts(ctx context.Context, in *ListSecretConflictsRequest, opts ...grpc.CallOption) (*ListSecretConflictsResponse, error) {
+	out := new(ListSecretConflictsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/ListSecretConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ResolveSecretConflict(ctx context.Context, in *ResolveSecretConflictRequest, opts ...grpc.CallOption) (*ResolveSecretConflictResponse, error) {
+	out := new(ResolveSecretConflictResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/ResolveSecretConflict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) ExportSecrets(ctx context.Context, in *ExportSecretsRequest, opts ...grpc.CallOption) (*ExportSecretsResponse, error) {
+	out := new(ExportSecretsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/ExportSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *secretsClient) RestoreSecrets(ctx context.Context, in *RestoreSecretsRequest, opts ...grpc.CallOption) (*RestoreSecretsResponse, error) {
+	out := new(RestoreSecretsResponse)
+	err := c.cc.Invoke(ctx, "/proto.Secrets/RestoreSecrets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecretsServer is the server API for Secrets service.
 // All implementations must embed UnimplementedSecretsServer
 // for forward compatibility
 type SecretsServer interface {
 	CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error)
+	CreateSecrets(context.Context, *CreateSecretsRequest) (*CreateSecretsResponse, error)
 	ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error)
 	GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error)
+	GetSecretOTP(context.Context, *GetSecretOTPRequest) (*GetSecretOTPResponse, error)
 	UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error)
 	DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error)
+	UndeleteSecret(context.Context, *UndeleteSecretRequest) (*UndeleteSecretResponse, error)
+	ListSecretVersions(context.Context, *ListSecretVersionsRequest) (*ListSecretVersionsResponse, error)
+	RestoreSecretVersion(context.Context, *RestoreSecretVersionRequest) (*RestoreSecretVersionResponse, error)
+	ListSecretConflicts(context.Context, *ListSecretConflictsRequest) (*ListSecretConflictsResponse, error)
+	ResolveSecretConflict(context.Context, *ResolveSecretConflictRequest) (*ResolveSecretConflictResponse, error)
+	ExportSecrets(context.Context, *ExportSecretsRequest) (*ExportSecretsResponse, error)
+	RestoreSecrets(context.Context, *RestoreSecretsRequest) (*RestoreSecretsResponse, error)
 	mustEmbedUnimplementedSecretsServer()
 }
 
@@ -101,18 +200,45 @@ type UnimplementedSecretsServer struct {
 func (UnimplementedSecretsServer) CreateSecret(context.Context, *CreateSecretRequest) (*CreateSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateSecret not implemented")
 }
+func (UnimplementedSecretsServer) CreateSecrets(context.Context, *CreateSecretsRequest) (*CreateSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSecrets not implemented")
+}
 func (UnimplementedSecretsServer) ListSecrets(context.Context, *ListSecretsRequest) (*ListSecretsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListSecrets not implemented")
 }
 func (UnimplementedSecretsServer) GetSecret(context.Context, *GetSecretRequest) (*GetSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSecret not implemented")
 }
+func (UnimplementedSecretsServer) GetSecretOTP(context.Context, *GetSecretOTPRequest) (*GetSecretOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSecretOTP not implemented")
+}
 func (UnimplementedSecretsServer) UpdateSecret(context.Context, *UpdateSecretRequest) (*UpdateSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSecret not implemented")
 }
 func (UnimplementedSecretsServer) DeleteSecret(context.Context, *DeleteSecretRequest) (*DeleteSecretResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteSecret not implemented")
 }
+func (UnimplementedSecretsServer) UndeleteSecret(context.Context, *UndeleteSecretRequest) (*UndeleteSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UndeleteSecret not implemented")
+}
+func (UnimplementedSecretsServer) ListSecretVersions(context.Context, *ListSecretVersionsRequest) (*ListSecretVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecretVersions not implemented")
+}
+func (UnimplementedSecretsServer) RestoreSecretVersion(context.Context, *RestoreSecretVersionRequest) (*RestoreSecretVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSecretVersion not implemented")
+}
+func (UnimplementedSecretsServer) ListSecretConflicts(context.Context, *ListSecretConflictsRequest) (*ListSecretConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSecretConflicts not implemented")
+}
+func (UnimplementedSecretsServer) ResolveSecretConflict(context.Context, *ResolveSecretConflictRequest) (*ResolveSecretConflictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveSecretConflict not implemented")
+}
+func (UnimplementedSecretsServer) ExportSecrets(context.Context, *ExportSecretsRequest) (*ExportSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportSecrets not implemented")
+}
+func (UnimplementedSecretsServer) RestoreSecrets(context.Context, *RestoreSecretsRequest) (*RestoreSecretsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreSecrets not implemented")
+}
 func (UnimplementedSecretsServer) mustEmbedUnimplementedSecretsServer() {}
 
 // UnsafeSecretsServer may be embedded to opt out of forward compatibility for this service.
@@ -144,6 +270,24 @@ func _Secrets_CreateSecret_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_CreateSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).CreateSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/CreateSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).CreateSecrets(ctx, req.(*CreateSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Secrets_ListSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListSecretsRequest)
 	if err := dec(in); err != nil {
@@ -180,6 +324,24 @@ func _Secrets_GetSecret_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_GetSecretOTP_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSecretOTPRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).GetSecretOTP(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/GetSecretOTP",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).GetSecretOTP(ctx, req.(*GetSecretOTPRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Secrets_UpdateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateSecretRequest)
 	if err := dec(in); err != nil {
@@ -216,6 +378,132 @@ func _Secrets_DeleteSecret_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Secrets_UndeleteSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UndeleteSecretRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).UndeleteSecret(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/UndeleteSecret",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).UndeleteSecret(ctx, req.(*UndeleteSecretRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ListSecretVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListSecretVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/ListSecretVersions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListSecretVersions(ctx, req.(*ListSecretVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RestoreSecretVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSecretVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RestoreSecretVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/RestoreSecretVersion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RestoreSecretVersion(ctx, req.(*RestoreSecretVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ListSecretConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSecretConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ListSecretConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/ListSecretConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ListSecretConflicts(ctx, req.(*ListSecretConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ResolveSecretConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveSecretConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ResolveSecretConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/ResolveSecretConflict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ResolveSecretConflict(ctx, req.(*ResolveSecretConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_ExportSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).ExportSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/ExportSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).ExportSecrets(ctx, req.(*ExportSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Secrets_RestoreSecrets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreSecretsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecretsServer).RestoreSecrets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Secrets/RestoreSecrets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecretsServer).RestoreSecrets(ctx, req.(*RestoreSecretsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Secrets_ServiceDesc is the grpc.ServiceDesc for Secrets service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -227,6 +515,10 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateSecret",
 			Handler:    _Secrets_CreateSecret_Handler,
 		},
+		{
+			MethodName: "CreateSecrets",
+			Handler:    _Secrets_CreateSecrets_Handler,
+		},
 		{
 			MethodName: "ListSecrets",
 			Handler:    _Secrets_ListSecrets_Handler,
@@ -235,6 +527,10 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetSecret",
 			Handler:    _Secrets_GetSecret_Handler,
 		},
+		{
+			MethodName: "GetSecretOTP",
+			Handler:    _Secrets_GetSecretOTP_Handler,
+		},
 		{
 			MethodName: "UpdateSecret",
 			Handler:    _Secrets_UpdateSecret_Handler,
@@ -243,6 +539,34 @@ var Secrets_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteSecret",
 			Handler:    _Secrets_DeleteSecret_Handler,
 		},
+		{
+			MethodName: "UndeleteSecret",
+			Handler:    _Secrets_UndeleteSecret_Handler,
+		},
+		{
+			MethodName: "ListSecretVersions",
+			Handler:    _Secrets_ListSecretVersions_Handler,
+		},
+		{
+			MethodName: "RestoreSecretVersion",
+			Handler:    _Secrets_RestoreSecretVersion_Handler,
+		},
+		{
+			MethodName: "ListSecretConflicts",
+			Handler:    _Secrets_ListSecretConflicts_Handler,
+		},
+		{
+			MethodName: "ResolveSecretConflict",
+			Handler:    _Secrets_ResolveSecretConflict_Handler,
+		},
+		{
+			MethodName: "ExportSecrets",
+			Handler:    _Secrets_ExportSecrets_Handler,
+		},
+		{
+			MethodName: "RestoreSecrets",
+			Handler:    _Secrets_RestoreSecrets_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/proto/gpwd.proto",
@@ -442,12 +766,207 @@ var Accounts_ServiceDesc = grpc.ServiceDesc{
 	Metadata: "internal/proto/gpwd.proto",
 }
 
+// AgentClient is the client API for Agent service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AgentClient interface {
+	Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error)
+	Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error)
+	SyncStatus(ctx context.Context, in *SyncStatusRequest, opts ...grpc.CallOption) (*SyncStatusResponse, error)
+	SyncNow(ctx context.Context, in *SyncNowRequest, opts ...grpc.CallOption) (*SyncNowResponse, error)
+}
+
+type agentClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentClient(cc grpc.ClientConnInterface) AgentClient {
+	return &agentClient{cc}
+}
+
+func (c *agentClient) Lock(ctx context.Context, in *LockRequest, opts ...grpc.CallOption) (*LockResponse, error) {
+	out := new(LockResponse)
+	err := c.cc.Invoke(ctx, "/proto.Agent/Lock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) Unlock(ctx context.Context, in *UnlockRequest, opts ...grpc.CallOption) (*UnlockResponse, error) {
+	out := new(UnlockResponse)
+	err := c.cc.Invoke(ctx, "/proto.Agent/Unlock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SyncStatus(ctx context.Context, in *SyncStatusRequest, opts ...grpc.CallOption) (*SyncStatusResponse, error) {
+	out := new(SyncStatusResponse)
+	err := c.cc.Invoke(ctx, "/proto.Agent/SyncStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SyncNow(ctx context.Context, in *SyncNowRequest, opts ...grpc.CallOption) (*SyncNowResponse, error) {
+	out := new(SyncNowResponse)
+	err := c.cc.Invoke(ctx, "/proto.Agent/SyncNow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AgentServer is the server API for Agent service.
+// All implementations must embed UnimplementedAgentServer
+// for forward compatibility
+type AgentServer interface {
+	Lock(context.Context, *LockRequest) (*LockResponse, error)
+	Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error)
+	SyncStatus(context.Context, *SyncStatusRequest) (*SyncStatusResponse, error)
+	SyncNow(context.Context, *SyncNowRequest) (*SyncNowResponse, error)
+	mustEmbedUnimplementedAgentServer()
+}
+
+// UnimplementedAgentServer must be embedded to have forward compatible implementations.
+type UnimplementedAgentServer struct {
+}
+
+func (UnimplementedAgentServer) Lock(context.Context, *LockRequest) (*LockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Lock not implemented")
+}
+func (UnimplementedAgentServer) Unlock(context.Context, *UnlockRequest) (*UnlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unlock not implemented")
+}
+func (UnimplementedAgentServer) SyncStatus(context.Context, *SyncStatusRequest) (*SyncStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncStatus not implemented")
+}
+func (UnimplementedAgentServer) SyncNow(context.Context, *SyncNowRequest) (*SyncNowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyncNow not implemented")
+}
+func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
+
+// UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AgentServer will
+// result in compilation errors.
+type UnsafeAgentServer interface {
+	mustEmbedUnimplementedAgentServer()
+}
+
+func RegisterAgentServer(s grpc.ServiceRegistrar, srv AgentServer) {
+	s.RegisterService(&Agent_ServiceDesc, srv)
+}
+
+func _Agent_Lock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Lock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Agent/Lock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Lock(ctx, req.(*LockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_Unlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).Unlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Agent/Unlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).Unlock(ctx, req.(*UnlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SyncStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SyncStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Agent/SyncStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SyncStatus(ctx, req.(*SyncStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SyncNow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncNowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SyncNow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Agent/SyncNow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SyncNow(ctx, req.(*SyncNowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Agent_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "proto.Agent",
+	HandlerType: (*AgentServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Lock",
+			Handler:    _Agent_Lock_Handler,
+		},
+		{
+			MethodName: "Unlock",
+			Handler:    _Agent_Unlock_Handler,
+		},
+		{
+			MethodName: "SyncStatus",
+			Handler:    _Agent_SyncStatus_Handler,
+		},
+		{
+			MethodName: "SyncNow",
+			Handler:    _Agent_SyncNow_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "internal/proto/gpwd.proto",
+}
+
 // LoginClient is the client API for Login service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type LoginClient interface {
 	RegisterAccount(ctx context.Context, in *RegisterAccountRequest, opts ...grpc.CallOption) (*RegisterAccountResponse, error)
 	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	UpdateVaultKey(ctx context.Context, in *UpdateVaultKeyRequest, opts ...grpc.CallOption) (*UpdateVaultKeyResponse, error)
 }
 
 type loginClient struct {
@@ -476,12 +995,22 @@ func (c *loginClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.
 	return out, nil
 }
 
+func (c *loginClient) UpdateVaultKey(ctx context.Context, in *UpdateVaultKeyRequest, opts ...grpc.CallOption) (*UpdateVaultKeyResponse, error) {
+	out := new(UpdateVaultKeyResponse)
+	err := c.cc.Invoke(ctx, "/proto.Login/UpdateVaultKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LoginServer is the server API for Login service.
 // All implementations must embed UnimplementedLoginServer
 // for forward compatibility
 type LoginServer interface {
 	RegisterAccount(context.Context, *RegisterAccountRequest) (*RegisterAccountResponse, error)
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	UpdateVaultKey(context.Context, *UpdateVaultKeyRequest) (*UpdateVaultKeyResponse, error)
 	mustEmbedUnimplementedLoginServer()
 }
 
@@ -495,6 +1024,9 @@ func (UnimplementedLoginServer) RegisterAccount(context.Context, *RegisterAccoun
 func (UnimplementedLoginServer) Login(context.Context, *LoginRequest) (*LoginResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Login not implemented")
 }
+func (UnimplementedLoginServer) UpdateVaultKey(context.Context, *UpdateVaultKeyRequest) (*UpdateVaultKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateVaultKey not implemented")
+}
 func (UnimplementedLoginServer) mustEmbedUnimplementedLoginServer() {}
 
 // UnsafeLoginServer may be embedded to opt out of forward compatibility for this service.
@@ -544,6 +1076,24 @@ func _Login_Login_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Login_UpdateVaultKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateVaultKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LoginServer).UpdateVaultKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/proto.Login/UpdateVaultKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LoginServer).UpdateVaultKey(ctx, req.(*UpdateVaultKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Login_ServiceDesc is the grpc.ServiceDesc for Login service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -559,6 +1109,10 @@ var Login_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Login_Login_Handler,
 		},
+		{
+			MethodName: "UpdateVaultKey",
+			Handler:    _Login_UpdateVaultKey_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "internal/proto/gpwd.proto",
@@ -568,10 +1122,8 @@ var Login_ServiceDesc = grpc.ServiceDesc{
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type SyncClient interface {
-	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (Sync_SyncClient, error)
-	SyncDeleted(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncDeletedClient, error)
-	SyncUpdated(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncUpdatedClient, error)
-	SyncCreated(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncCreatedClient, error)
+	Sync(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncClient, error)
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Sync_WatchClient, error)
 }
 
 type syncClient struct {
@@ -582,22 +1134,17 @@ func NewSyncClient(cc grpc.ClientConnInterface) SyncClient {
 	return &syncClient{cc}
 }
 
-func (c *syncClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (Sync_SyncClient, error) {
+func (c *syncClient) Sync(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncClient, error) {
 	stream, err := c.cc.NewStream(ctx, &Sync_ServiceDesc.Streams[0], "/proto.Sync/Sync", opts...)
 	if err != nil {
 		return nil, err
 	}
 	x := &syncSyncClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
 	return x, nil
 }
 
 type Sync_SyncClient interface {
+	Send(*SyncRequest) error
 	Recv() (*SyncResponse, error)
 	grpc.ClientStream
 }
@@ -606,41 +1153,11 @@ type syncSyncClient struct {
 	grpc.ClientStream
 }
 
-func (x *syncSyncClient) Recv() (*SyncResponse, error) {
-	m := new(SyncResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
-func (c *syncClient) SyncDeleted(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncDeletedClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Sync_ServiceDesc.Streams[1], "/proto.Sync/SyncDeleted", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &syncSyncDeletedClient{stream}
-	return x, nil
-}
-
-type Sync_SyncDeletedClient interface {
-	Send(*SyncRequest) error
-	CloseAndRecv() (*SyncResponse, error)
-	grpc.ClientStream
-}
-
-type syncSyncDeletedClient struct {
-	grpc.ClientStream
-}
-
-func (x *syncSyncDeletedClient) Send(m *SyncRequest) error {
+func (x *syncSyncClient) Send(m *SyncRequest) error {
 	return x.ClientStream.SendMsg(m)
 }
 
-func (x *syncSyncDeletedClient) CloseAndRecv() (*SyncResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
+func (x *syncSyncClient) Recv() (*SyncResponse, error) {
 	m := new(SyncResponse)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -648,68 +1165,32 @@ func (x *syncSyncDeletedClient) CloseAndRecv() (*SyncResponse, error) {
 	return m, nil
 }
 
-func (c *syncClient) SyncUpdated(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncUpdatedClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Sync_ServiceDesc.Streams[2], "/proto.Sync/SyncUpdated", opts...)
+func (c *syncClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (Sync_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Sync_ServiceDesc.Streams[1], "/proto.Sync/Watch", opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &syncSyncUpdatedClient{stream}
-	return x, nil
-}
-
-type Sync_SyncUpdatedClient interface {
-	Send(*SyncRequest) error
-	CloseAndRecv() (*SyncResponse, error)
-	grpc.ClientStream
-}
-
-type syncSyncUpdatedClient struct {
-	grpc.ClientStream
-}
-
-func (x *syncSyncUpdatedClient) Send(m *SyncRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *syncSyncUpdatedClient) CloseAndRecv() (*SyncResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(SyncResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+	x := &syncWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func (c *syncClient) SyncCreated(ctx context.Context, opts ...grpc.CallOption) (Sync_SyncCreatedClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Sync_ServiceDesc.Streams[3], "/proto.Sync/SyncCreated", opts...)
-	if err != nil {
+	if err := x.ClientStream.CloseSend(); err != nil {
 		return nil, err
 	}
-	x := &syncSyncCreatedClient{stream}
 	return x, nil
 }
 
-type Sync_SyncCreatedClient interface {
-	Send(*SyncRequest) error
-	CloseAndRecv() (*SyncResponse, error)
+type Sync_WatchClient interface {
+	Recv() (*WatchEvent, error)
 	grpc.ClientStream
 }
 
-type syncSyncCreatedClient struct {
+type syncWatchClient struct {
 	grpc.ClientStream
 }
 
-func (x *syncSyncCreatedClient) Send(m *SyncRequest) error {
-	return x.ClientStream.SendMsg(m)
-}
-
-func (x *syncSyncCreatedClient) CloseAndRecv() (*SyncResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	m := new(SyncResponse)
+func (x *syncWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
 	if err := x.ClientStream.RecvMsg(m); err != nil {
 		return nil, err
 	}
@@ -720,10 +1201,8 @@ func (x *syncSyncCreatedClient) CloseAndRecv() (*SyncResponse, error) {
 // All implementations must embed UnimplementedSyncServer
 // for forward compatibility
 type SyncServer interface {
-	Sync(*SyncRequest, Sync_SyncServer) error
-	SyncDeleted(Sync_SyncDeletedServer) error
-	SyncUpdated(Sync_SyncUpdatedServer) error
-	SyncCreated(Sync_SyncCreatedServer) error
+	Sync(Sync_SyncServer) error
+	Watch(*WatchRequest, Sync_WatchServer) error
 	mustEmbedUnimplementedSyncServer()
 }
 
@@ -731,17 +1210,11 @@ type SyncServer interface {
 type UnimplementedSyncServer struct {
 }
 
-func (UnimplementedSyncServer) Sync(*SyncRequest, Sync_SyncServer) error {
+func (UnimplementedSyncServer) Sync(Sync_SyncServer) error {
 	return status.Errorf(codes.Unimplemented, "method Sync not implemented")
 }
-func (UnimplementedSyncServer) SyncDeleted(Sync_SyncDeletedServer) error {
-	return status.Errorf(codes.Unimplemented, "method SyncDeleted not implemented")
-}
-func (UnimplementedSyncServer) SyncUpdated(Sync_SyncUpdatedServer) error {
-	return status.Errorf(codes.Unimplemented, "method SyncUpdated not implemented")
-}
-func (UnimplementedSyncServer) SyncCreated(Sync_SyncCreatedServer) error {
-	return status.Errorf(codes.Unimplemented, "method SyncCreated not implemented")
+func (UnimplementedSyncServer) Watch(*WatchRequest, Sync_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
 func (UnimplementedSyncServer) mustEmbedUnimplementedSyncServer() {}
 
@@ -757,15 +1230,12 @@ func RegisterSyncServer(s grpc.ServiceRegistrar, srv SyncServer) {
 }
 
 func _Sync_Sync_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(SyncRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(SyncServer).Sync(m, &syncSyncServer{stream})
+	return srv.(SyncServer).Sync(&syncSyncServer{stream})
 }
 
 type Sync_SyncServer interface {
 	Send(*SyncResponse) error
+	Recv() (*SyncRequest, error)
 	grpc.ServerStream
 }
 
@@ -777,25 +1247,7 @@ func (x *syncSyncServer) Send(m *SyncResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _Sync_SyncDeleted_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SyncServer).SyncDeleted(&syncSyncDeletedServer{stream})
-}
-
-type Sync_SyncDeletedServer interface {
-	SendAndClose(*SyncResponse) error
-	Recv() (*SyncRequest, error)
-	grpc.ServerStream
-}
-
-type syncSyncDeletedServer struct {
-	grpc.ServerStream
-}
-
-func (x *syncSyncDeletedServer) SendAndClose(m *SyncResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *syncSyncDeletedServer) Recv() (*SyncRequest, error) {
+func (x *syncSyncServer) Recv() (*SyncRequest, error) {
 	m := new(SyncRequest)
 	if err := x.ServerStream.RecvMsg(m); err != nil {
 		return nil, err
@@ -803,58 +1255,27 @@ func (x *syncSyncDeletedServer) Recv() (*SyncRequest, error) {
 	return m, nil
 }
 
-func _Sync_SyncUpdated_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SyncServer).SyncUpdated(&syncSyncUpdatedServer{stream})
-}
-
-type Sync_SyncUpdatedServer interface {
-	SendAndClose(*SyncResponse) error
-	Recv() (*SyncRequest, error)
-	grpc.ServerStream
-}
-
-type syncSyncUpdatedServer struct {
-	grpc.ServerStream
-}
-
-func (x *syncSyncUpdatedServer) SendAndClose(m *SyncResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *syncSyncUpdatedServer) Recv() (*SyncRequest, error) {
-	m := new(SyncRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
+func _Sync_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return m, nil
+	return srv.(SyncServer).Watch(m, &syncWatchServer{stream})
 }
 
-func _Sync_SyncCreated_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(SyncServer).SyncCreated(&syncSyncCreatedServer{stream})
-}
-
-type Sync_SyncCreatedServer interface {
-	SendAndClose(*SyncResponse) error
-	Recv() (*SyncRequest, error)
+type Sync_WatchServer interface {
+	Send(*WatchEvent) error
 	grpc.ServerStream
 }
 
-type syncSyncCreatedServer struct {
+type syncWatchServer struct {
 	grpc.ServerStream
 }
 
-func (x *syncSyncCreatedServer) SendAndClose(m *SyncResponse) error {
+func (x *syncWatchServer) Send(m *WatchEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func (x *syncSyncCreatedServer) Recv() (*SyncRequest, error) {
-	m := new(SyncRequest)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
-}
-
 // Sync_ServiceDesc is the grpc.ServiceDesc for Sync service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -867,21 +1288,12 @@ var Sync_ServiceDesc = grpc.ServiceDesc{
 			StreamName:    "Sync",
 			Handler:       _Sync_Sync_Handler,
 			ServerStreams: true,
-		},
-		{
-			StreamName:    "SyncDeleted",
-			Handler:       _Sync_SyncDeleted_Handler,
 			ClientStreams: true,
 		},
 		{
-			StreamName:    "SyncUpdated",
-			Handler:       _Sync_SyncUpdated_Handler,
-			ClientStreams: true,
-		},
-		{
-			StreamName:    "SyncCreated",
-			Handler:       _Sync_SyncCreated_Handler,
-			ClientStreams: true,
+			StreamName:    "Watch",
+			Handler:       _Sync_Watch_Handler,
+			ServerStreams: true,
 		},
 	},
 	Metadata: "internal/proto/gpwd.proto",
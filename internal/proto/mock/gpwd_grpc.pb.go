@@ -57,6 +57,26 @@ func (mr *MockSecretsClientMockRecorder) CreateSecret(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretsClient)(nil).CreateSecret), varargs...)
 }
 
+// CreateSecrets mocks base method.
+func (m *MockSecretsClient) CreateSecrets(ctx context.Context, in *proto.CreateSecretsRequest, opts ...grpc.CallOption) (*proto.CreateSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateSecrets", varargs...)
+	ret0, _ := ret[0].(*proto.CreateSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecrets indicates an expected call of CreateSecrets.
+func (mr *MockSecretsClientMockRecorder) CreateSecrets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecrets", reflect.TypeOf((*MockSecretsClient)(nil).CreateSecrets), varargs...)
+}
+
 // DeleteSecret mocks base method.
 func (m *MockSecretsClient) DeleteSecret(ctx context.Context, in *proto.DeleteSecretRequest, opts ...grpc.CallOption) (*proto.DeleteSecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -77,6 +97,26 @@ func (mr *MockSecretsClientMockRecorder) DeleteSecret(ctx, in interface{}, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockSecretsClient)(nil).DeleteSecret), varargs...)
 }
 
+// ExportSecrets mocks base method.
+func (m *MockSecretsClient) ExportSecrets(ctx context.Context, in *proto.ExportSecretsRequest, opts ...grpc.CallOption) (*proto.ExportSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExportSecrets", varargs...)
+	ret0, _ := ret[0].(*proto.ExportSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSecrets indicates an expected call of ExportSecrets.
+func (mr *MockSecretsClientMockRecorder) ExportSecrets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSecrets", reflect.TypeOf((*MockSecretsClient)(nil).ExportSecrets), varargs...)
+}
+
 // GetSecret mocks base method.
 func (m *MockSecretsClient) GetSecret(ctx context.Context, in *proto.GetSecretRequest, opts ...grpc.CallOption) (*proto.GetSecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +137,66 @@ func (mr *MockSecretsClientMockRecorder) GetSecret(ctx, in interface{}, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretsClient)(nil).GetSecret), varargs...)
 }
 
+// GetSecretOTP mocks base method.
+func (m *MockSecretsClient) GetSecretOTP(ctx context.Context, in *proto.GetSecretOTPRequest, opts ...grpc.CallOption) (*proto.GetSecretOTPResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecretOTP", varargs...)
+	ret0, _ := ret[0].(*proto.GetSecretOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretOTP indicates an expected call of GetSecretOTP.
+func (mr *MockSecretsClientMockRecorder) GetSecretOTP(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretOTP", reflect.TypeOf((*MockSecretsClient)(nil).GetSecretOTP), varargs...)
+}
+
+// ListSecretConflicts mocks base method.
+func (m *MockSecretsClient) ListSecretConflicts(ctx context.Context, in *proto.ListSecretConflictsRequest, opts ...grpc.CallOption) (*proto.ListSecretConflictsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecretConflicts", varargs...)
+	ret0, _ := ret[0].(*proto.ListSecretConflictsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretConflicts indicates an expected call of ListSecretConflicts.
+func (mr *MockSecretsClientMockRecorder) ListSecretConflicts(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretConflicts", reflect.TypeOf((*MockSecretsClient)(nil).ListSecretConflicts), varargs...)
+}
+
+// ListSecretVersions mocks base method.
+func (m *MockSecretsClient) ListSecretVersions(ctx context.Context, in *proto.ListSecretVersionsRequest, opts ...grpc.CallOption) (*proto.ListSecretVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecretVersions", varargs...)
+	ret0, _ := ret[0].(*proto.ListSecretVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretVersions indicates an expected call of ListSecretVersions.
+func (mr *MockSecretsClientMockRecorder) ListSecretVersions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretVersions", reflect.TypeOf((*MockSecretsClient)(nil).ListSecretVersions), varargs...)
+}
+
 // ListSecrets mocks base method.
 func (m *MockSecretsClient) ListSecrets(ctx context.Context, in *proto.ListSecretsRequest, opts ...grpc.CallOption) (*proto.ListSecretsResponse, error) {
 	m.ctrl.T.Helper()
@@ -117,6 +217,86 @@ func (mr *MockSecretsClientMockRecorder) ListSecrets(ctx, in interface{}, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretsClient)(nil).ListSecrets), varargs...)
 }
 
+// ResolveSecretConflict mocks base method.
+func (m *MockSecretsClient) ResolveSecretConflict(ctx context.Context, in *proto.ResolveSecretConflictRequest, opts ...grpc.CallOption) (*proto.ResolveSecretConflictResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ResolveSecretConflict", varargs...)
+	ret0, _ := ret[0].(*proto.ResolveSecretConflictResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSecretConflict indicates an expected call of ResolveSecretConflict.
+func (mr *MockSecretsClientMockRecorder) ResolveSecretConflict(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSecretConflict", reflect.TypeOf((*MockSecretsClient)(nil).ResolveSecretConflict), varargs...)
+}
+
+// RestoreSecretVersion mocks base method.
+func (m *MockSecretsClient) RestoreSecretVersion(ctx context.Context, in *proto.RestoreSecretVersionRequest, opts ...grpc.CallOption) (*proto.RestoreSecretVersionResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreSecretVersion", varargs...)
+	ret0, _ := ret[0].(*proto.RestoreSecretVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSecretVersion indicates an expected call of RestoreSecretVersion.
+func (mr *MockSecretsClientMockRecorder) RestoreSecretVersion(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecretVersion", reflect.TypeOf((*MockSecretsClient)(nil).RestoreSecretVersion), varargs...)
+}
+
+// RestoreSecrets mocks base method.
+func (m *MockSecretsClient) RestoreSecrets(ctx context.Context, in *proto.RestoreSecretsRequest, opts ...grpc.CallOption) (*proto.RestoreSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RestoreSecrets", varargs...)
+	ret0, _ := ret[0].(*proto.RestoreSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSecrets indicates an expected call of RestoreSecrets.
+func (mr *MockSecretsClientMockRecorder) RestoreSecrets(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecrets", reflect.TypeOf((*MockSecretsClient)(nil).RestoreSecrets), varargs...)
+}
+
+// UndeleteSecret mocks base method.
+func (m *MockSecretsClient) UndeleteSecret(ctx context.Context, in *proto.UndeleteSecretRequest, opts ...grpc.CallOption) (*proto.UndeleteSecretResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UndeleteSecret", varargs...)
+	ret0, _ := ret[0].(*proto.UndeleteSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndeleteSecret indicates an expected call of UndeleteSecret.
+func (mr *MockSecretsClientMockRecorder) UndeleteSecret(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteSecret", reflect.TypeOf((*MockSecretsClient)(nil).UndeleteSecret), varargs...)
+}
+
 // UpdateSecret mocks base method.
 func (m *MockSecretsClient) UpdateSecret(ctx context.Context, in *proto.UpdateSecretRequest, opts ...grpc.CallOption) (*proto.UpdateSecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +355,21 @@ func (mr *MockSecretsServerMockRecorder) CreateSecret(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecret", reflect.TypeOf((*MockSecretsServer)(nil).CreateSecret), arg0, arg1)
 }
 
+// CreateSecrets mocks base method.
+func (m *MockSecretsServer) CreateSecrets(arg0 context.Context, arg1 *proto.CreateSecretsRequest) (*proto.CreateSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecrets", arg0, arg1)
+	ret0, _ := ret[0].(*proto.CreateSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecrets indicates an expected call of CreateSecrets.
+func (mr *MockSecretsServerMockRecorder) CreateSecrets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecrets", reflect.TypeOf((*MockSecretsServer)(nil).CreateSecrets), arg0, arg1)
+}
+
 // DeleteSecret mocks base method.
 func (m *MockSecretsServer) DeleteSecret(arg0 context.Context, arg1 *proto.DeleteSecretRequest) (*proto.DeleteSecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +385,21 @@ func (mr *MockSecretsServerMockRecorder) DeleteSecret(arg0, arg1 interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecret", reflect.TypeOf((*MockSecretsServer)(nil).DeleteSecret), arg0, arg1)
 }
 
+// ExportSecrets mocks base method.
+func (m *MockSecretsServer) ExportSecrets(arg0 context.Context, arg1 *proto.ExportSecretsRequest) (*proto.ExportSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportSecrets", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ExportSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportSecrets indicates an expected call of ExportSecrets.
+func (mr *MockSecretsServerMockRecorder) ExportSecrets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportSecrets", reflect.TypeOf((*MockSecretsServer)(nil).ExportSecrets), arg0, arg1)
+}
+
 // GetSecret mocks base method.
 func (m *MockSecretsServer) GetSecret(arg0 context.Context, arg1 *proto.GetSecretRequest) (*proto.GetSecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +415,51 @@ func (mr *MockSecretsServerMockRecorder) GetSecret(arg0, arg1 interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecret", reflect.TypeOf((*MockSecretsServer)(nil).GetSecret), arg0, arg1)
 }
 
+// GetSecretOTP mocks base method.
+func (m *MockSecretsServer) GetSecretOTP(arg0 context.Context, arg1 *proto.GetSecretOTPRequest) (*proto.GetSecretOTPResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSecretOTP", arg0, arg1)
+	ret0, _ := ret[0].(*proto.GetSecretOTPResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSecretOTP indicates an expected call of GetSecretOTP.
+func (mr *MockSecretsServerMockRecorder) GetSecretOTP(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecretOTP", reflect.TypeOf((*MockSecretsServer)(nil).GetSecretOTP), arg0, arg1)
+}
+
+// ListSecretConflicts mocks base method.
+func (m *MockSecretsServer) ListSecretConflicts(arg0 context.Context, arg1 *proto.ListSecretConflictsRequest) (*proto.ListSecretConflictsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretConflicts", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListSecretConflictsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretConflicts indicates an expected call of ListSecretConflicts.
+func (mr *MockSecretsServerMockRecorder) ListSecretConflicts(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretConflicts", reflect.TypeOf((*MockSecretsServer)(nil).ListSecretConflicts), arg0, arg1)
+}
+
+// ListSecretVersions mocks base method.
+func (m *MockSecretsServer) ListSecretVersions(arg0 context.Context, arg1 *proto.ListSecretVersionsRequest) (*proto.ListSecretVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecretVersions", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ListSecretVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecretVersions indicates an expected call of ListSecretVersions.
+func (mr *MockSecretsServerMockRecorder) ListSecretVersions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecretVersions", reflect.TypeOf((*MockSecretsServer)(nil).ListSecretVersions), arg0, arg1)
+}
+
 // ListSecrets mocks base method.
 func (m *MockSecretsServer) ListSecrets(arg0 context.Context, arg1 *proto.ListSecretsRequest) (*proto.ListSecretsResponse, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +475,66 @@ func (mr *MockSecretsServerMockRecorder) ListSecrets(arg0, arg1 interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecrets", reflect.TypeOf((*MockSecretsServer)(nil).ListSecrets), arg0, arg1)
 }
 
+// ResolveSecretConflict mocks base method.
+func (m *MockSecretsServer) ResolveSecretConflict(arg0 context.Context, arg1 *proto.ResolveSecretConflictRequest) (*proto.ResolveSecretConflictResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSecretConflict", arg0, arg1)
+	ret0, _ := ret[0].(*proto.ResolveSecretConflictResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSecretConflict indicates an expected call of ResolveSecretConflict.
+func (mr *MockSecretsServerMockRecorder) ResolveSecretConflict(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSecretConflict", reflect.TypeOf((*MockSecretsServer)(nil).ResolveSecretConflict), arg0, arg1)
+}
+
+// RestoreSecretVersion mocks base method.
+func (m *MockSecretsServer) RestoreSecretVersion(arg0 context.Context, arg1 *proto.RestoreSecretVersionRequest) (*proto.RestoreSecretVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSecretVersion", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RestoreSecretVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSecretVersion indicates an expected call of RestoreSecretVersion.
+func (mr *MockSecretsServerMockRecorder) RestoreSecretVersion(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecretVersion", reflect.TypeOf((*MockSecretsServer)(nil).RestoreSecretVersion), arg0, arg1)
+}
+
+// RestoreSecrets mocks base method.
+func (m *MockSecretsServer) RestoreSecrets(arg0 context.Context, arg1 *proto.RestoreSecretsRequest) (*proto.RestoreSecretsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreSecrets", arg0, arg1)
+	ret0, _ := ret[0].(*proto.RestoreSecretsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreSecrets indicates an expected call of RestoreSecrets.
+func (mr *MockSecretsServerMockRecorder) RestoreSecrets(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreSecrets", reflect.TypeOf((*MockSecretsServer)(nil).RestoreSecrets), arg0, arg1)
+}
+
+// UndeleteSecret mocks base method.
+func (m *MockSecretsServer) UndeleteSecret(arg0 context.Context, arg1 *proto.UndeleteSecretRequest) (*proto.UndeleteSecretResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UndeleteSecret", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UndeleteSecretResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UndeleteSecret indicates an expected call of UndeleteSecret.
+func (mr *MockSecretsServerMockRecorder) UndeleteSecret(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UndeleteSecret", reflect.TypeOf((*MockSecretsServer)(nil).UndeleteSecret), arg0, arg1)
+}
+
 // UpdateSecret mocks base method.
 func (m *MockSecretsServer) UpdateSecret(arg0 context.Context, arg1 *proto.UpdateSecretRequest) (*proto.UpdateSecretResponse, error) {
 	m.ctrl.T.Helper()
@@ -515,102 +830,355 @@ func (mr *MockUnsafeAccountsServerMockRecorder) mustEmbedUnimplementedAccountsSe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAccountsServer", reflect.TypeOf((*MockUnsafeAccountsServer)(nil).mustEmbedUnimplementedAccountsServer))
 }
 
-// MockLoginClient is a mock of LoginClient interface.
-type MockLoginClient struct {
+// MockAgentClient is a mock of AgentClient interface.
+type MockAgentClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockLoginClientMockRecorder
+	recorder *MockAgentClientMockRecorder
 }
 
-// MockLoginClientMockRecorder is the mock recorder for MockLoginClient.
-type MockLoginClientMockRecorder struct {
-	mock *MockLoginClient
+// MockAgentClientMockRecorder is the mock recorder for MockAgentClient.
+type MockAgentClientMockRecorder struct {
+	mock *MockAgentClient
 }
 
-// NewMockLoginClient creates a new mock instance.
-func NewMockLoginClient(ctrl *gomock.Controller) *MockLoginClient {
-	mock := &MockLoginClient{ctrl: ctrl}
-	mock.recorder = &MockLoginClientMockRecorder{mock}
+// NewMockAgentClient creates a new mock instance.
+func NewMockAgentClient(ctrl *gomock.Controller) *MockAgentClient {
+	mock := &MockAgentClient{ctrl: ctrl}
+	mock.recorder = &MockAgentClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLoginClient) EXPECT() *MockLoginClientMockRecorder {
+func (m *MockAgentClient) EXPECT() *MockAgentClientMockRecorder {
 	return m.recorder
 }
 
-// Login mocks base method.
-func (m *MockLoginClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.LoginResponse, error) {
+// Lock mocks base method.
+func (m *MockAgentClient) Lock(ctx context.Context, in *proto.LockRequest, opts ...grpc.CallOption) (*proto.LockResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "Login", varargs...)
-	ret0, _ := ret[0].(*proto.LoginResponse)
+	ret := m.ctrl.Call(m, "Lock", varargs...)
+	ret0, _ := ret[0].(*proto.LockResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
-func (mr *MockLoginClientMockRecorder) Login(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// Lock indicates an expected call of Lock.
+func (mr *MockAgentClientMockRecorder) Lock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockLoginClient)(nil).Login), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockAgentClient)(nil).Lock), varargs...)
 }
 
-// RegisterAccount mocks base method.
-func (m *MockLoginClient) RegisterAccount(ctx context.Context, in *proto.RegisterAccountRequest, opts ...grpc.CallOption) (*proto.RegisterAccountResponse, error) {
+// SyncNow mocks base method.
+func (m *MockAgentClient) SyncNow(ctx context.Context, in *proto.SyncNowRequest, opts ...grpc.CallOption) (*proto.SyncNowResponse, error) {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "RegisterAccount", varargs...)
-	ret0, _ := ret[0].(*proto.RegisterAccountResponse)
+	ret := m.ctrl.Call(m, "SyncNow", varargs...)
+	ret0, _ := ret[0].(*proto.SyncNowResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// RegisterAccount indicates an expected call of RegisterAccount.
-func (mr *MockLoginClientMockRecorder) RegisterAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+// SyncNow indicates an expected call of SyncNow.
+func (mr *MockAgentClientMockRecorder) SyncNow(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAccount", reflect.TypeOf((*MockLoginClient)(nil).RegisterAccount), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncNow", reflect.TypeOf((*MockAgentClient)(nil).SyncNow), varargs...)
 }
 
-// MockLoginServer is a mock of LoginServer interface.
-type MockLoginServer struct {
+// SyncStatus mocks base method.
+func (m *MockAgentClient) SyncStatus(ctx context.Context, in *proto.SyncStatusRequest, opts ...grpc.CallOption) (*proto.SyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SyncStatus", varargs...)
+	ret0, _ := ret[0].(*proto.SyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus.
+func (mr *MockAgentClientMockRecorder) SyncStatus(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockAgentClient)(nil).SyncStatus), varargs...)
+}
+
+// Unlock mocks base method.
+func (m *MockAgentClient) Unlock(ctx context.Context, in *proto.UnlockRequest, opts ...grpc.CallOption) (*proto.UnlockResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Unlock", varargs...)
+	ret0, _ := ret[0].(*proto.UnlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockAgentClientMockRecorder) Unlock(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockAgentClient)(nil).Unlock), varargs...)
+}
+
+// MockAgentServer is a mock of AgentServer interface.
+type MockAgentServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockLoginServerMockRecorder
+	recorder *MockAgentServerMockRecorder
 }
 
-// MockLoginServerMockRecorder is the mock recorder for MockLoginServer.
-type MockLoginServerMockRecorder struct {
-	mock *MockLoginServer
+// MockAgentServerMockRecorder is the mock recorder for MockAgentServer.
+type MockAgentServerMockRecorder struct {
+	mock *MockAgentServer
 }
 
-// NewMockLoginServer creates a new mock instance.
-func NewMockLoginServer(ctrl *gomock.Controller) *MockLoginServer {
-	mock := &MockLoginServer{ctrl: ctrl}
-	mock.recorder = &MockLoginServerMockRecorder{mock}
+// NewMockAgentServer creates a new mock instance.
+func NewMockAgentServer(ctrl *gomock.Controller) *MockAgentServer {
+	mock := &MockAgentServer{ctrl: ctrl}
+	mock.recorder = &MockAgentServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockLoginServer) EXPECT() *MockLoginServerMockRecorder {
+func (m *MockAgentServer) EXPECT() *MockAgentServerMockRecorder {
 	return m.recorder
 }
 
-// Login mocks base method.
-func (m *MockLoginServer) Login(arg0 context.Context, arg1 *proto.LoginRequest) (*proto.LoginResponse, error) {
+// Lock mocks base method.
+func (m *MockAgentServer) Lock(arg0 context.Context, arg1 *proto.LockRequest) (*proto.LockResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Login", arg0, arg1)
-	ret0, _ := ret[0].(*proto.LoginResponse)
+	ret := m.ctrl.Call(m, "Lock", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LockResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Login indicates an expected call of Login.
+// Lock indicates an expected call of Lock.
+func (mr *MockAgentServerMockRecorder) Lock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockAgentServer)(nil).Lock), arg0, arg1)
+}
+
+// SyncNow mocks base method.
+func (m *MockAgentServer) SyncNow(arg0 context.Context, arg1 *proto.SyncNowRequest) (*proto.SyncNowResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncNow", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SyncNowResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncNow indicates an expected call of SyncNow.
+func (mr *MockAgentServerMockRecorder) SyncNow(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncNow", reflect.TypeOf((*MockAgentServer)(nil).SyncNow), arg0, arg1)
+}
+
+// SyncStatus mocks base method.
+func (m *MockAgentServer) SyncStatus(arg0 context.Context, arg1 *proto.SyncStatusRequest) (*proto.SyncStatusResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStatus", arg0, arg1)
+	ret0, _ := ret[0].(*proto.SyncStatusResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStatus indicates an expected call of SyncStatus.
+func (mr *MockAgentServerMockRecorder) SyncStatus(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStatus", reflect.TypeOf((*MockAgentServer)(nil).SyncStatus), arg0, arg1)
+}
+
+// Unlock mocks base method.
+func (m *MockAgentServer) Unlock(arg0 context.Context, arg1 *proto.UnlockRequest) (*proto.UnlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unlock", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UnlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Unlock indicates an expected call of Unlock.
+func (mr *MockAgentServerMockRecorder) Unlock(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unlock", reflect.TypeOf((*MockAgentServer)(nil).Unlock), arg0, arg1)
+}
+
+// mustEmbedUnimplementedAgentServer mocks base method.
+func (m *MockAgentServer) mustEmbedUnimplementedAgentServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedAgentServer")
+}
+
+// mustEmbedUnimplementedAgentServer indicates an expected call of mustEmbedUnimplementedAgentServer.
+func (mr *MockAgentServerMockRecorder) mustEmbedUnimplementedAgentServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAgentServer", reflect.TypeOf((*MockAgentServer)(nil).mustEmbedUnimplementedAgentServer))
+}
+
+// MockUnsafeAgentServer is a mock of UnsafeAgentServer interface.
+type MockUnsafeAgentServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnsafeAgentServerMockRecorder
+}
+
+// MockUnsafeAgentServerMockRecorder is the mock recorder for MockUnsafeAgentServer.
+type MockUnsafeAgentServerMockRecorder struct {
+	mock *MockUnsafeAgentServer
+}
+
+// NewMockUnsafeAgentServer creates a new mock instance.
+func NewMockUnsafeAgentServer(ctrl *gomock.Controller) *MockUnsafeAgentServer {
+	mock := &MockUnsafeAgentServer{ctrl: ctrl}
+	mock.recorder = &MockUnsafeAgentServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnsafeAgentServer) EXPECT() *MockUnsafeAgentServerMockRecorder {
+	return m.recorder
+}
+
+// mustEmbedUnimplementedAgentServer mocks base method.
+func (m *MockUnsafeAgentServer) mustEmbedUnimplementedAgentServer() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "mustEmbedUnimplementedAgentServer")
+}
+
+// mustEmbedUnimplementedAgentServer indicates an expected call of mustEmbedUnimplementedAgentServer.
+func (mr *MockUnsafeAgentServerMockRecorder) mustEmbedUnimplementedAgentServer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAgentServer", reflect.TypeOf((*MockUnsafeAgentServer)(nil).mustEmbedUnimplementedAgentServer))
+}
+
+// MockLoginClient is a mock of LoginClient interface.
+type MockLoginClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginClientMockRecorder
+}
+
+// MockLoginClientMockRecorder is the mock recorder for MockLoginClient.
+type MockLoginClientMockRecorder struct {
+	mock *MockLoginClient
+}
+
+// NewMockLoginClient creates a new mock instance.
+func NewMockLoginClient(ctrl *gomock.Controller) *MockLoginClient {
+	mock := &MockLoginClient{ctrl: ctrl}
+	mock.recorder = &MockLoginClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginClient) EXPECT() *MockLoginClientMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockLoginClient) Login(ctx context.Context, in *proto.LoginRequest, opts ...grpc.CallOption) (*proto.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "Login", varargs...)
+	ret0, _ := ret[0].(*proto.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
+func (mr *MockLoginClientMockRecorder) Login(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockLoginClient)(nil).Login), varargs...)
+}
+
+// RegisterAccount mocks base method.
+func (m *MockLoginClient) RegisterAccount(ctx context.Context, in *proto.RegisterAccountRequest, opts ...grpc.CallOption) (*proto.RegisterAccountResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RegisterAccount", varargs...)
+	ret0, _ := ret[0].(*proto.RegisterAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterAccount indicates an expected call of RegisterAccount.
+func (mr *MockLoginClientMockRecorder) RegisterAccount(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAccount", reflect.TypeOf((*MockLoginClient)(nil).RegisterAccount), varargs...)
+}
+
+// UpdateVaultKey mocks base method.
+func (m *MockLoginClient) UpdateVaultKey(ctx context.Context, in *proto.UpdateVaultKeyRequest, opts ...grpc.CallOption) (*proto.UpdateVaultKeyResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateVaultKey", varargs...)
+	ret0, _ := ret[0].(*proto.UpdateVaultKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVaultKey indicates an expected call of UpdateVaultKey.
+func (mr *MockLoginClientMockRecorder) UpdateVaultKey(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVaultKey", reflect.TypeOf((*MockLoginClient)(nil).UpdateVaultKey), varargs...)
+}
+
+// MockLoginServer is a mock of LoginServer interface.
+type MockLoginServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockLoginServerMockRecorder
+}
+
+// MockLoginServerMockRecorder is the mock recorder for MockLoginServer.
+type MockLoginServerMockRecorder struct {
+	mock *MockLoginServer
+}
+
+// NewMockLoginServer creates a new mock instance.
+func NewMockLoginServer(ctrl *gomock.Controller) *MockLoginServer {
+	mock := &MockLoginServer{ctrl: ctrl}
+	mock.recorder = &MockLoginServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLoginServer) EXPECT() *MockLoginServerMockRecorder {
+	return m.recorder
+}
+
+// Login mocks base method.
+func (m *MockLoginServer) Login(arg0 context.Context, arg1 *proto.LoginRequest) (*proto.LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Login", arg0, arg1)
+	ret0, _ := ret[0].(*proto.LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Login indicates an expected call of Login.
 func (mr *MockLoginServerMockRecorder) Login(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockLoginServer)(nil).Login), arg0, arg1)
@@ -631,6 +1199,21 @@ func (mr *MockLoginServerMockRecorder) RegisterAccount(arg0, arg1 interface{}) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterAccount", reflect.TypeOf((*MockLoginServer)(nil).RegisterAccount), arg0, arg1)
 }
 
+// UpdateVaultKey mocks base method.
+func (m *MockLoginServer) UpdateVaultKey(arg0 context.Context, arg1 *proto.UpdateVaultKeyRequest) (*proto.UpdateVaultKeyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVaultKey", arg0, arg1)
+	ret0, _ := ret[0].(*proto.UpdateVaultKeyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVaultKey indicates an expected call of UpdateVaultKey.
+func (mr *MockLoginServerMockRecorder) UpdateVaultKey(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVaultKey", reflect.TypeOf((*MockLoginServer)(nil).UpdateVaultKey), arg0, arg1)
+}
+
 // mustEmbedUnimplementedLoginServer mocks base method.
 func (m *MockLoginServer) mustEmbedUnimplementedLoginServer() {
 	m.ctrl.T.Helper()
@@ -702,9 +1285,9 @@ func (m *MockSyncClient) EXPECT() *MockSyncClientMockRecorder {
 }
 
 // Sync mocks base method.
-func (m *MockSyncClient) Sync(ctx context.Context, in *proto.SyncRequest, opts ...grpc.CallOption) (proto.Sync_SyncClient, error) {
+func (m *MockSyncClient) Sync(ctx context.Context, opts ...grpc.CallOption) (proto.Sync_SyncClient, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx, in}
+	varargs := []interface{}{ctx}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
@@ -715,70 +1298,30 @@ func (m *MockSyncClient) Sync(ctx context.Context, in *proto.SyncRequest, opts .
 }
 
 // Sync indicates an expected call of Sync.
-func (mr *MockSyncClientMockRecorder) Sync(ctx, in interface{}, opts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx, in}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockSyncClient)(nil).Sync), varargs...)
-}
-
-// SyncCreated mocks base method.
-func (m *MockSyncClient) SyncCreated(ctx context.Context, opts ...grpc.CallOption) (proto.Sync_SyncCreatedClient, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SyncCreated", varargs...)
-	ret0, _ := ret[0].(proto.Sync_SyncCreatedClient)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// SyncCreated indicates an expected call of SyncCreated.
-func (mr *MockSyncClientMockRecorder) SyncCreated(ctx interface{}, opts ...interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncCreated", reflect.TypeOf((*MockSyncClient)(nil).SyncCreated), varargs...)
-}
-
-// SyncDeleted mocks base method.
-func (m *MockSyncClient) SyncDeleted(ctx context.Context, opts ...grpc.CallOption) (proto.Sync_SyncDeletedClient, error) {
-	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "SyncDeleted", varargs...)
-	ret0, _ := ret[0].(proto.Sync_SyncDeletedClient)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// SyncDeleted indicates an expected call of SyncDeleted.
-func (mr *MockSyncClientMockRecorder) SyncDeleted(ctx interface{}, opts ...interface{}) *gomock.Call {
+func (mr *MockSyncClientMockRecorder) Sync(ctx interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]interface{}{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncDeleted", reflect.TypeOf((*MockSyncClient)(nil).SyncDeleted), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockSyncClient)(nil).Sync), varargs...)
 }
 
-// SyncUpdated mocks base method.
-func (m *MockSyncClient) SyncUpdated(ctx context.Context, opts ...grpc.CallOption) (proto.Sync_SyncUpdatedClient, error) {
+// Watch mocks base method.
+func (m *MockSyncClient) Watch(ctx context.Context, in *proto.WatchRequest, opts ...grpc.CallOption) (proto.Sync_WatchClient, error) {
 	m.ctrl.T.Helper()
-	varargs := []interface{}{ctx}
+	varargs := []interface{}{ctx, in}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "SyncUpdated", varargs...)
-	ret0, _ := ret[0].(proto.Sync_SyncUpdatedClient)
+	ret := m.ctrl.Call(m, "Watch", varargs...)
+	ret0, _ := ret[0].(proto.Sync_WatchClient)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SyncUpdated indicates an expected call of SyncUpdated.
-func (mr *MockSyncClientMockRecorder) SyncUpdated(ctx interface{}, opts ...interface{}) *gomock.Call {
+// Watch indicates an expected call of Watch.
+func (mr *MockSyncClientMockRecorder) Watch(ctx, in interface{}, opts ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]interface{}{ctx}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncUpdated", reflect.TypeOf((*MockSyncClient)(nil).SyncUpdated), varargs...)
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockSyncClient)(nil).Watch), varargs...)
 }
 
 // MockSync_SyncClient is a mock of Sync_SyncClient interface.
@@ -876,6 +1419,20 @@ func (mr *MockSync_SyncClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncClient)(nil).RecvMsg), m)
 }
 
+// Send mocks base method.
+func (m *MockSync_SyncClient) Send(arg0 *proto.SyncRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockSync_SyncClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSync_SyncClient)(nil).Send), arg0)
+}
+
 // SendMsg mocks base method.
 func (m_2 *MockSync_SyncClient) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
@@ -904,46 +1461,31 @@ func (mr *MockSync_SyncClientMockRecorder) Trailer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSync_SyncClient)(nil).Trailer))
 }
 
-// MockSync_SyncDeletedClient is a mock of Sync_SyncDeletedClient interface.
-type MockSync_SyncDeletedClient struct {
+// MockSync_WatchClient is a mock of Sync_WatchClient interface.
+type MockSync_WatchClient struct {
 	ctrl     *gomock.Controller
-	recorder *MockSync_SyncDeletedClientMockRecorder
+	recorder *MockSync_WatchClientMockRecorder
 }
 
-// MockSync_SyncDeletedClientMockRecorder is the mock recorder for MockSync_SyncDeletedClient.
-type MockSync_SyncDeletedClientMockRecorder struct {
-	mock *MockSync_SyncDeletedClient
+// MockSync_WatchClientMockRecorder is the mock recorder for MockSync_WatchClient.
+type MockSync_WatchClientMockRecorder struct {
+	mock *MockSync_WatchClient
 }
 
-// NewMockSync_SyncDeletedClient creates a new mock instance.
-func NewMockSync_SyncDeletedClient(ctrl *gomock.Controller) *MockSync_SyncDeletedClient {
-	mock := &MockSync_SyncDeletedClient{ctrl: ctrl}
-	mock.recorder = &MockSync_SyncDeletedClientMockRecorder{mock}
+// NewMockSync_WatchClient creates a new mock instance.
+func NewMockSync_WatchClient(ctrl *gomock.Controller) *MockSync_WatchClient {
+	mock := &MockSync_WatchClient{ctrl: ctrl}
+	mock.recorder = &MockSync_WatchClientMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSync_SyncDeletedClient) EXPECT() *MockSync_SyncDeletedClientMockRecorder {
+func (m *MockSync_WatchClient) EXPECT() *MockSync_WatchClientMockRecorder {
 	return m.recorder
 }
 
-// CloseAndRecv mocks base method.
-func (m *MockSync_SyncDeletedClient) CloseAndRecv() (*proto.SyncResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseAndRecv")
-	ret0, _ := ret[0].(*proto.SyncResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CloseAndRecv indicates an expected call of CloseAndRecv.
-func (mr *MockSync_SyncDeletedClientMockRecorder) CloseAndRecv() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).CloseAndRecv))
-}
-
 // CloseSend mocks base method.
-func (m *MockSync_SyncDeletedClient) CloseSend() error {
+func (m *MockSync_WatchClient) CloseSend() error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CloseSend")
 	ret0, _ := ret[0].(error)
@@ -951,13 +1493,13 @@ func (m *MockSync_SyncDeletedClient) CloseSend() error {
 }
 
 // CloseSend indicates an expected call of CloseSend.
-func (mr *MockSync_SyncDeletedClientMockRecorder) CloseSend() *gomock.Call {
+func (mr *MockSync_WatchClientMockRecorder) CloseSend() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).CloseSend))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSync_WatchClient)(nil).CloseSend))
 }
 
 // Context mocks base method.
-func (m *MockSync_SyncDeletedClient) Context() context.Context {
+func (m *MockSync_WatchClient) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -965,13 +1507,13 @@ func (m *MockSync_SyncDeletedClient) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockSync_SyncDeletedClientMockRecorder) Context() *gomock.Call {
+func (mr *MockSync_WatchClientMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).Context))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_WatchClient)(nil).Context))
 }
 
 // Header mocks base method.
-func (m *MockSync_SyncDeletedClient) Header() (metadata.MD, error) {
+func (m *MockSync_WatchClient) Header() (metadata.MD, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Header")
 	ret0, _ := ret[0].(metadata.MD)
@@ -980,41 +1522,42 @@ func (m *MockSync_SyncDeletedClient) Header() (metadata.MD, error) {
 }
 
 // Header indicates an expected call of Header.
-func (mr *MockSync_SyncDeletedClientMockRecorder) Header() *gomock.Call {
+func (mr *MockSync_WatchClientMockRecorder) Header() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).Header))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSync_WatchClient)(nil).Header))
 }
 
-// RecvMsg mocks base method.
-func (m_2 *MockSync_SyncDeletedClient) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
+// Recv mocks base method.
+func (m *MockSync_WatchClient) Recv() (*proto.WatchEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.WatchEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockSync_SyncDeletedClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+// Recv indicates an expected call of Recv.
+func (mr *MockSync_WatchClientMockRecorder) Recv() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).RecvMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSync_WatchClient)(nil).Recv))
 }
 
-// Send mocks base method.
-func (m *MockSync_SyncDeletedClient) Send(arg0 *proto.SyncRequest) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
+// RecvMsg mocks base method.
+func (m_2 *MockSync_WatchClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Send indicates an expected call of Send.
-func (mr *MockSync_SyncDeletedClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockSync_WatchClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).Send), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_WatchClient)(nil).RecvMsg), m)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockSync_SyncDeletedClient) SendMsg(m interface{}) error {
+func (m_2 *MockSync_WatchClient) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1022,13 +1565,13 @@ func (m_2 *MockSync_SyncDeletedClient) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockSync_SyncDeletedClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockSync_WatchClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_WatchClient)(nil).SendMsg), m)
 }
 
 // Trailer mocks base method.
-func (m *MockSync_SyncDeletedClient) Trailer() metadata.MD {
+func (m *MockSync_WatchClient) Trailer() metadata.MD {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Trailer")
 	ret0, _ := ret[0].(metadata.MD)
@@ -1036,362 +1579,60 @@ func (m *MockSync_SyncDeletedClient) Trailer() metadata.MD {
 }
 
 // Trailer indicates an expected call of Trailer.
-func (mr *MockSync_SyncDeletedClientMockRecorder) Trailer() *gomock.Call {
+func (mr *MockSync_WatchClientMockRecorder) Trailer() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSync_SyncDeletedClient)(nil).Trailer))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSync_WatchClient)(nil).Trailer))
 }
 
-// MockSync_SyncUpdatedClient is a mock of Sync_SyncUpdatedClient interface.
-type MockSync_SyncUpdatedClient struct {
+// MockSyncServer is a mock of SyncServer interface.
+type MockSyncServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockSync_SyncUpdatedClientMockRecorder
+	recorder *MockSyncServerMockRecorder
 }
 
-// MockSync_SyncUpdatedClientMockRecorder is the mock recorder for MockSync_SyncUpdatedClient.
-type MockSync_SyncUpdatedClientMockRecorder struct {
-	mock *MockSync_SyncUpdatedClient
+// MockSyncServerMockRecorder is the mock recorder for MockSyncServer.
+type MockSyncServerMockRecorder struct {
+	mock *MockSyncServer
 }
 
-// NewMockSync_SyncUpdatedClient creates a new mock instance.
-func NewMockSync_SyncUpdatedClient(ctrl *gomock.Controller) *MockSync_SyncUpdatedClient {
-	mock := &MockSync_SyncUpdatedClient{ctrl: ctrl}
-	mock.recorder = &MockSync_SyncUpdatedClientMockRecorder{mock}
+// NewMockSyncServer creates a new mock instance.
+func NewMockSyncServer(ctrl *gomock.Controller) *MockSyncServer {
+	mock := &MockSyncServer{ctrl: ctrl}
+	mock.recorder = &MockSyncServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSync_SyncUpdatedClient) EXPECT() *MockSync_SyncUpdatedClientMockRecorder {
+func (m *MockSyncServer) EXPECT() *MockSyncServerMockRecorder {
 	return m.recorder
 }
 
-// CloseAndRecv mocks base method.
-func (m *MockSync_SyncUpdatedClient) CloseAndRecv() (*proto.SyncResponse, error) {
+// Sync mocks base method.
+func (m *MockSyncServer) Sync(arg0 proto.Sync_SyncServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseAndRecv")
-	ret0, _ := ret[0].(*proto.SyncResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CloseAndRecv indicates an expected call of CloseAndRecv.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) CloseAndRecv() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).CloseAndRecv))
-}
-
-// CloseSend mocks base method.
-func (m *MockSync_SyncUpdatedClient) CloseSend() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseSend")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CloseSend indicates an expected call of CloseSend.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) CloseSend() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).CloseSend))
-}
-
-// Context mocks base method.
-func (m *MockSync_SyncUpdatedClient) Context() context.Context {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
-}
-
-// Context indicates an expected call of Context.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).Context))
-}
-
-// Header mocks base method.
-func (m *MockSync_SyncUpdatedClient) Header() (metadata.MD, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Header")
-	ret0, _ := ret[0].(metadata.MD)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Header indicates an expected call of Header.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) Header() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).Header))
-}
-
-// RecvMsg mocks base method.
-func (m_2 *MockSync_SyncUpdatedClient) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).RecvMsg), m)
-}
-
-// Send mocks base method.
-func (m *MockSync_SyncUpdatedClient) Send(arg0 *proto.SyncRequest) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Send indicates an expected call of Send.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).Send), arg0)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockSync_SyncUpdatedClient) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).SendMsg), m)
-}
-
-// Trailer mocks base method.
-func (m *MockSync_SyncUpdatedClient) Trailer() metadata.MD {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trailer")
-	ret0, _ := ret[0].(metadata.MD)
-	return ret0
-}
-
-// Trailer indicates an expected call of Trailer.
-func (mr *MockSync_SyncUpdatedClientMockRecorder) Trailer() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSync_SyncUpdatedClient)(nil).Trailer))
-}
-
-// MockSync_SyncCreatedClient is a mock of Sync_SyncCreatedClient interface.
-type MockSync_SyncCreatedClient struct {
-	ctrl     *gomock.Controller
-	recorder *MockSync_SyncCreatedClientMockRecorder
-}
-
-// MockSync_SyncCreatedClientMockRecorder is the mock recorder for MockSync_SyncCreatedClient.
-type MockSync_SyncCreatedClientMockRecorder struct {
-	mock *MockSync_SyncCreatedClient
-}
-
-// NewMockSync_SyncCreatedClient creates a new mock instance.
-func NewMockSync_SyncCreatedClient(ctrl *gomock.Controller) *MockSync_SyncCreatedClient {
-	mock := &MockSync_SyncCreatedClient{ctrl: ctrl}
-	mock.recorder = &MockSync_SyncCreatedClientMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSync_SyncCreatedClient) EXPECT() *MockSync_SyncCreatedClientMockRecorder {
-	return m.recorder
-}
-
-// CloseAndRecv mocks base method.
-func (m *MockSync_SyncCreatedClient) CloseAndRecv() (*proto.SyncResponse, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseAndRecv")
-	ret0, _ := ret[0].(*proto.SyncResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// CloseAndRecv indicates an expected call of CloseAndRecv.
-func (mr *MockSync_SyncCreatedClientMockRecorder) CloseAndRecv() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).CloseAndRecv))
-}
-
-// CloseSend mocks base method.
-func (m *MockSync_SyncCreatedClient) CloseSend() error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CloseSend")
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// CloseSend indicates an expected call of CloseSend.
-func (mr *MockSync_SyncCreatedClientMockRecorder) CloseSend() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).CloseSend))
-}
-
-// Context mocks base method.
-func (m *MockSync_SyncCreatedClient) Context() context.Context {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
-}
-
-// Context indicates an expected call of Context.
-func (mr *MockSync_SyncCreatedClientMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).Context))
-}
-
-// Header mocks base method.
-func (m *MockSync_SyncCreatedClient) Header() (metadata.MD, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Header")
-	ret0, _ := ret[0].(metadata.MD)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Header indicates an expected call of Header.
-func (mr *MockSync_SyncCreatedClientMockRecorder) Header() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).Header))
-}
-
-// RecvMsg mocks base method.
-func (m_2 *MockSync_SyncCreatedClient) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockSync_SyncCreatedClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).RecvMsg), m)
-}
-
-// Send mocks base method.
-func (m *MockSync_SyncCreatedClient) Send(arg0 *proto.SyncRequest) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// Send indicates an expected call of Send.
-func (mr *MockSync_SyncCreatedClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).Send), arg0)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockSync_SyncCreatedClient) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockSync_SyncCreatedClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).SendMsg), m)
-}
-
-// Trailer mocks base method.
-func (m *MockSync_SyncCreatedClient) Trailer() metadata.MD {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Trailer")
-	ret0, _ := ret[0].(metadata.MD)
-	return ret0
-}
-
-// Trailer indicates an expected call of Trailer.
-func (mr *MockSync_SyncCreatedClientMockRecorder) Trailer() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockSync_SyncCreatedClient)(nil).Trailer))
-}
-
-// MockSyncServer is a mock of SyncServer interface.
-type MockSyncServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockSyncServerMockRecorder
-}
-
-// MockSyncServerMockRecorder is the mock recorder for MockSyncServer.
-type MockSyncServerMockRecorder struct {
-	mock *MockSyncServer
-}
-
-// NewMockSyncServer creates a new mock instance.
-func NewMockSyncServer(ctrl *gomock.Controller) *MockSyncServer {
-	mock := &MockSyncServer{ctrl: ctrl}
-	mock.recorder = &MockSyncServerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSyncServer) EXPECT() *MockSyncServerMockRecorder {
-	return m.recorder
-}
-
-// Sync mocks base method.
-func (m *MockSyncServer) Sync(arg0 *proto.SyncRequest, arg1 proto.Sync_SyncServer) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Sync", arg0, arg1)
+	ret := m.ctrl.Call(m, "Sync", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Sync indicates an expected call of Sync.
-func (mr *MockSyncServerMockRecorder) Sync(arg0, arg1 interface{}) *gomock.Call {
+func (mr *MockSyncServerMockRecorder) Sync(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockSyncServer)(nil).Sync), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockSyncServer)(nil).Sync), arg0)
 }
 
-// SyncCreated mocks base method.
-func (m *MockSyncServer) SyncCreated(arg0 proto.Sync_SyncCreatedServer) error {
+// Watch mocks base method.
+func (m *MockSyncServer) Watch(arg0 *proto.WatchRequest, arg1 proto.Sync_WatchServer) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncCreated", arg0)
+	ret := m.ctrl.Call(m, "Watch", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SyncCreated indicates an expected call of SyncCreated.
-func (mr *MockSyncServerMockRecorder) SyncCreated(arg0 interface{}) *gomock.Call {
+// Watch indicates an expected call of Watch.
+func (mr *MockSyncServerMockRecorder) Watch(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncCreated", reflect.TypeOf((*MockSyncServer)(nil).SyncCreated), arg0)
-}
-
-// SyncDeleted mocks base method.
-func (m *MockSyncServer) SyncDeleted(arg0 proto.Sync_SyncDeletedServer) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncDeleted", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SyncDeleted indicates an expected call of SyncDeleted.
-func (mr *MockSyncServerMockRecorder) SyncDeleted(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncDeleted", reflect.TypeOf((*MockSyncServer)(nil).SyncDeleted), arg0)
-}
-
-// SyncUpdated mocks base method.
-func (m *MockSyncServer) SyncUpdated(arg0 proto.Sync_SyncUpdatedServer) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SyncUpdated", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SyncUpdated indicates an expected call of SyncUpdated.
-func (mr *MockSyncServerMockRecorder) SyncUpdated(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncUpdated", reflect.TypeOf((*MockSyncServer)(nil).SyncUpdated), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Watch", reflect.TypeOf((*MockSyncServer)(nil).Watch), arg0, arg1)
 }
 
 // mustEmbedUnimplementedSyncServer mocks base method.
@@ -1478,6 +1719,21 @@ func (mr *MockSync_SyncServerMockRecorder) Context() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncServer)(nil).Context))
 }
 
+// Recv mocks base method.
+func (m *MockSync_SyncServer) Recv() (*proto.SyncRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*proto.SyncRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockSync_SyncServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSync_SyncServer)(nil).Recv))
+}
+
 // RecvMsg mocks base method.
 func (m_2 *MockSync_SyncServer) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
@@ -1560,31 +1816,31 @@ func (mr *MockSync_SyncServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSync_SyncServer)(nil).SetTrailer), arg0)
 }
 
-// MockSync_SyncDeletedServer is a mock of Sync_SyncDeletedServer interface.
-type MockSync_SyncDeletedServer struct {
+// MockSync_WatchServer is a mock of Sync_WatchServer interface.
+type MockSync_WatchServer struct {
 	ctrl     *gomock.Controller
-	recorder *MockSync_SyncDeletedServerMockRecorder
+	recorder *MockSync_WatchServerMockRecorder
 }
 
-// MockSync_SyncDeletedServerMockRecorder is the mock recorder for MockSync_SyncDeletedServer.
-type MockSync_SyncDeletedServerMockRecorder struct {
-	mock *MockSync_SyncDeletedServer
+// MockSync_WatchServerMockRecorder is the mock recorder for MockSync_WatchServer.
+type MockSync_WatchServerMockRecorder struct {
+	mock *MockSync_WatchServer
 }
 
-// NewMockSync_SyncDeletedServer creates a new mock instance.
-func NewMockSync_SyncDeletedServer(ctrl *gomock.Controller) *MockSync_SyncDeletedServer {
-	mock := &MockSync_SyncDeletedServer{ctrl: ctrl}
-	mock.recorder = &MockSync_SyncDeletedServerMockRecorder{mock}
+// NewMockSync_WatchServer creates a new mock instance.
+func NewMockSync_WatchServer(ctrl *gomock.Controller) *MockSync_WatchServer {
+	mock := &MockSync_WatchServer{ctrl: ctrl}
+	mock.recorder = &MockSync_WatchServerMockRecorder{mock}
 	return mock
 }
 
 // EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSync_SyncDeletedServer) EXPECT() *MockSync_SyncDeletedServerMockRecorder {
+func (m *MockSync_WatchServer) EXPECT() *MockSync_WatchServerMockRecorder {
 	return m.recorder
 }
 
 // Context mocks base method.
-func (m *MockSync_SyncDeletedServer) Context() context.Context {
+func (m *MockSync_WatchServer) Context() context.Context {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Context")
 	ret0, _ := ret[0].(context.Context)
@@ -1592,28 +1848,13 @@ func (m *MockSync_SyncDeletedServer) Context() context.Context {
 }
 
 // Context indicates an expected call of Context.
-func (mr *MockSync_SyncDeletedServerMockRecorder) Context() *gomock.Call {
+func (mr *MockSync_WatchServerMockRecorder) Context() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).Context))
-}
-
-// Recv mocks base method.
-func (m *MockSync_SyncDeletedServer) Recv() (*proto.SyncRequest, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*proto.SyncRequest)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Recv indicates an expected call of Recv.
-func (mr *MockSync_SyncDeletedServerMockRecorder) Recv() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_WatchServer)(nil).Context))
 }
 
 // RecvMsg mocks base method.
-func (m_2 *MockSync_SyncDeletedServer) RecvMsg(m interface{}) error {
+func (m_2 *MockSync_WatchServer) RecvMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1621,295 +1862,27 @@ func (m_2 *MockSync_SyncDeletedServer) RecvMsg(m interface{}) error {
 }
 
 // RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockSync_SyncDeletedServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).RecvMsg), m)
-}
-
-// SendAndClose mocks base method.
-func (m *MockSync_SyncDeletedServer) SendAndClose(arg0 *proto.SyncResponse) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendAndClose", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendAndClose indicates an expected call of SendAndClose.
-func (mr *MockSync_SyncDeletedServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).SendAndClose), arg0)
-}
-
-// SendHeader mocks base method.
-func (m *MockSync_SyncDeletedServer) SendHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendHeader indicates an expected call of SendHeader.
-func (mr *MockSync_SyncDeletedServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).SendHeader), arg0)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockSync_SyncDeletedServer) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockSync_SyncDeletedServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).SendMsg), m)
-}
-
-// SetHeader mocks base method.
-func (m *MockSync_SyncDeletedServer) SetHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetHeader indicates an expected call of SetHeader.
-func (mr *MockSync_SyncDeletedServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).SetHeader), arg0)
-}
-
-// SetTrailer mocks base method.
-func (m *MockSync_SyncDeletedServer) SetTrailer(arg0 metadata.MD) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetTrailer", arg0)
-}
-
-// SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockSync_SyncDeletedServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSync_SyncDeletedServer)(nil).SetTrailer), arg0)
-}
-
-// MockSync_SyncUpdatedServer is a mock of Sync_SyncUpdatedServer interface.
-type MockSync_SyncUpdatedServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockSync_SyncUpdatedServerMockRecorder
-}
-
-// MockSync_SyncUpdatedServerMockRecorder is the mock recorder for MockSync_SyncUpdatedServer.
-type MockSync_SyncUpdatedServerMockRecorder struct {
-	mock *MockSync_SyncUpdatedServer
-}
-
-// NewMockSync_SyncUpdatedServer creates a new mock instance.
-func NewMockSync_SyncUpdatedServer(ctrl *gomock.Controller) *MockSync_SyncUpdatedServer {
-	mock := &MockSync_SyncUpdatedServer{ctrl: ctrl}
-	mock.recorder = &MockSync_SyncUpdatedServerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSync_SyncUpdatedServer) EXPECT() *MockSync_SyncUpdatedServerMockRecorder {
-	return m.recorder
-}
-
-// Context mocks base method.
-func (m *MockSync_SyncUpdatedServer) Context() context.Context {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
-}
-
-// Context indicates an expected call of Context.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).Context))
-}
-
-// Recv mocks base method.
-func (m *MockSync_SyncUpdatedServer) Recv() (*proto.SyncRequest, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*proto.SyncRequest)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Recv indicates an expected call of Recv.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) Recv() *gomock.Call {
+func (mr *MockSync_WatchServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).Recv))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_WatchServer)(nil).RecvMsg), m)
 }
 
-// RecvMsg mocks base method.
-func (m_2 *MockSync_SyncUpdatedServer) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).RecvMsg), m)
-}
-
-// SendAndClose mocks base method.
-func (m *MockSync_SyncUpdatedServer) SendAndClose(arg0 *proto.SyncResponse) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendAndClose", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendAndClose indicates an expected call of SendAndClose.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).SendAndClose), arg0)
-}
-
-// SendHeader mocks base method.
-func (m *MockSync_SyncUpdatedServer) SendHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendHeader indicates an expected call of SendHeader.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).SendHeader), arg0)
-}
-
-// SendMsg mocks base method.
-func (m_2 *MockSync_SyncUpdatedServer) SendMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SendMsg indicates an expected call of SendMsg.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).SendMsg), m)
-}
-
-// SetHeader mocks base method.
-func (m *MockSync_SyncUpdatedServer) SetHeader(arg0 metadata.MD) error {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetHeader", arg0)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// SetHeader indicates an expected call of SetHeader.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).SetHeader), arg0)
-}
-
-// SetTrailer mocks base method.
-func (m *MockSync_SyncUpdatedServer) SetTrailer(arg0 metadata.MD) {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "SetTrailer", arg0)
-}
-
-// SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockSync_SyncUpdatedServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSync_SyncUpdatedServer)(nil).SetTrailer), arg0)
-}
-
-// MockSync_SyncCreatedServer is a mock of Sync_SyncCreatedServer interface.
-type MockSync_SyncCreatedServer struct {
-	ctrl     *gomock.Controller
-	recorder *MockSync_SyncCreatedServerMockRecorder
-}
-
-// MockSync_SyncCreatedServerMockRecorder is the mock recorder for MockSync_SyncCreatedServer.
-type MockSync_SyncCreatedServerMockRecorder struct {
-	mock *MockSync_SyncCreatedServer
-}
-
-// NewMockSync_SyncCreatedServer creates a new mock instance.
-func NewMockSync_SyncCreatedServer(ctrl *gomock.Controller) *MockSync_SyncCreatedServer {
-	mock := &MockSync_SyncCreatedServer{ctrl: ctrl}
-	mock.recorder = &MockSync_SyncCreatedServerMockRecorder{mock}
-	return mock
-}
-
-// EXPECT returns an object that allows the caller to indicate expected use.
-func (m *MockSync_SyncCreatedServer) EXPECT() *MockSync_SyncCreatedServerMockRecorder {
-	return m.recorder
-}
-
-// Context mocks base method.
-func (m *MockSync_SyncCreatedServer) Context() context.Context {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Context")
-	ret0, _ := ret[0].(context.Context)
-	return ret0
-}
-
-// Context indicates an expected call of Context.
-func (mr *MockSync_SyncCreatedServerMockRecorder) Context() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).Context))
-}
-
-// Recv mocks base method.
-func (m *MockSync_SyncCreatedServer) Recv() (*proto.SyncRequest, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Recv")
-	ret0, _ := ret[0].(*proto.SyncRequest)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// Recv indicates an expected call of Recv.
-func (mr *MockSync_SyncCreatedServerMockRecorder) Recv() *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).Recv))
-}
-
-// RecvMsg mocks base method.
-func (m_2 *MockSync_SyncCreatedServer) RecvMsg(m interface{}) error {
-	m_2.ctrl.T.Helper()
-	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
-	ret0, _ := ret[0].(error)
-	return ret0
-}
-
-// RecvMsg indicates an expected call of RecvMsg.
-func (mr *MockSync_SyncCreatedServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).RecvMsg), m)
-}
-
-// SendAndClose mocks base method.
-func (m *MockSync_SyncCreatedServer) SendAndClose(arg0 *proto.SyncResponse) error {
+// Send mocks base method.
+func (m *MockSync_WatchServer) Send(arg0 *proto.WatchEvent) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret := m.ctrl.Call(m, "Send", arg0)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// SendAndClose indicates an expected call of SendAndClose.
-func (mr *MockSync_SyncCreatedServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+// Send indicates an expected call of Send.
+func (mr *MockSync_WatchServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).SendAndClose), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockSync_WatchServer)(nil).Send), arg0)
 }
 
 // SendHeader mocks base method.
-func (m *MockSync_SyncCreatedServer) SendHeader(arg0 metadata.MD) error {
+func (m *MockSync_WatchServer) SendHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -1917,13 +1890,13 @@ func (m *MockSync_SyncCreatedServer) SendHeader(arg0 metadata.MD) error {
 }
 
 // SendHeader indicates an expected call of SendHeader.
-func (mr *MockSync_SyncCreatedServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockSync_WatchServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).SendHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockSync_WatchServer)(nil).SendHeader), arg0)
 }
 
 // SendMsg mocks base method.
-func (m_2 *MockSync_SyncCreatedServer) SendMsg(m interface{}) error {
+func (m_2 *MockSync_WatchServer) SendMsg(m interface{}) error {
 	m_2.ctrl.T.Helper()
 	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
 	ret0, _ := ret[0].(error)
@@ -1931,13 +1904,13 @@ func (m_2 *MockSync_SyncCreatedServer) SendMsg(m interface{}) error {
 }
 
 // SendMsg indicates an expected call of SendMsg.
-func (mr *MockSync_SyncCreatedServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+func (mr *MockSync_WatchServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).SendMsg), m)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockSync_WatchServer)(nil).SendMsg), m)
 }
 
 // SetHeader mocks base method.
-func (m *MockSync_SyncCreatedServer) SetHeader(arg0 metadata.MD) error {
+func (m *MockSync_WatchServer) SetHeader(arg0 metadata.MD) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SetHeader", arg0)
 	ret0, _ := ret[0].(error)
@@ -1945,19 +1918,19 @@ func (m *MockSync_SyncCreatedServer) SetHeader(arg0 metadata.MD) error {
 }
 
 // SetHeader indicates an expected call of SetHeader.
-func (mr *MockSync_SyncCreatedServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+func (mr *MockSync_WatchServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).SetHeader), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockSync_WatchServer)(nil).SetHeader), arg0)
 }
 
 // SetTrailer mocks base method.
-func (m *MockSync_SyncCreatedServer) SetTrailer(arg0 metadata.MD) {
+func (m *MockSync_WatchServer) SetTrailer(arg0 metadata.MD) {
 	m.ctrl.T.Helper()
 	m.ctrl.Call(m, "SetTrailer", arg0)
 }
 
 // SetTrailer indicates an expected call of SetTrailer.
-func (mr *MockSync_SyncCreatedServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+func (mr *MockSync_WatchServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSync_SyncCreatedServer)(nil).SetTrailer), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockSync_WatchServer)(nil).SetTrailer), arg0)
 }
@@ -0,0 +1,238 @@
+// Package selector implements Kubernetes style label selectors, e.g. env=prod,team!=infra,app in (api,web),owner,!temp.
+// Requirements are separated by commas and all of them must match
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Operator is the relation between the label and the values of the requirement
+type Operator string
+
+const (
+	Equals       Operator = "="
+	NotEquals    Operator = "!="
+	In           Operator = "in"
+	NotIn        Operator = "notin"
+	Exists       Operator = "exists"
+	DoesNotExist Operator = "!"
+)
+
+var (
+	ErrEmptyRequirement = errors.New("empty selector requirement")
+
+	// Keys are restricted, so they can be safely used in JSON paths
+	keyPattern = regexp.MustCompile(`^[A-Za-z0-9._/-]+$`)
+	setPattern = regexp.MustCompile(`^(\S+)\s+(in|notin)\s*\((.*)\)$`)
+)
+
+// Requirement is the single condition on the label
+type Requirement struct {
+	Key      string
+	Operator Operator
+	// Values are compared with the label value, there are none for Exists and DoesNotExist
+	Values []string
+}
+
+// Selector matches labels satisfying all requirements, the empty selector matches everything
+type Selector []Requirement
+
+// Parse parses the selector, the empty string gives the empty selector
+func Parse(selector string) (Selector, error) {
+	terms, err := split(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	parsed := make(Selector, 0, len(terms))
+	for _, term := range terms {
+		requirement, err := parseRequirement(term)
+		if err != nil {
+			return nil, fmt.Errorf("invalid selector %q: %w", selector, err)
+		}
+
+		parsed = append(parsed, requirement)
+	}
+
+	return parsed, nil
+}
+
+// Matches reports whether labels satisfy all requirements of the selector
+func (s Selector) Matches(labels map[string]string) bool {
+	for _, requirement := range s {
+		if !requirement.Matches(labels) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// String returns the selector in the canonical form
+func (s Selector) String() string {
+	terms := make([]string, 0, len(s))
+	for _, requirement := range s {
+		terms = append(terms, requirement.String())
+	}
+
+	return strings.Join(terms, ",")
+}
+
+// Matches reports whether labels satisfy the requirement, a missing label matches only != and notin
+func (r Requirement) Matches(labels map[string]string) bool {
+	value, ok := labels[r.Key]
+
+	switch r.Operator {
+	case Equals:
+		return ok && value == r.Values[0]
+	case NotEquals:
+		return !ok || value != r.Values[0]
+	case In:
+		return ok && contains(r.Values, value)
+	case NotIn:
+		return !ok || !contains(r.Values, value)
+	case Exists:
+		return ok
+	case DoesNotExist:
+		return !ok
+	default:
+		return false
+	}
+}
+
+func (r Requirement) String() string {
+	switch r.Operator {
+	case Equals, NotEquals:
+		return r.Key + string(r.Operator) + r.Values[0]
+	case In, NotIn:
+		return r.Key + " " + string(r.Operator) + " (" + strings.Join(r.Values, ",") + ")"
+	case DoesNotExist:
+		return "!" + r.Key
+	default:
+		return r.Key
+	}
+}
+
+func parseRequirement(term string) (Requirement, error) {
+	if match := setPattern.FindStringSubmatch(term); match != nil {
+		values, err := parseValues(strings.Split(match[3], ","))
+		if err != nil {
+			return Requirement{}, err
+		}
+		for _, value := range values {
+			if value == "" {
+				return Requirement{}, fmt.Errorf("%q has empty values", term)
+			}
+		}
+		sort.Strings(values)
+
+		return newRequirement(match[1], Operator(match[2]), values)
+	}
+
+	if strings.ContainsAny(term, "()") {
+		return Requirement{}, fmt.Errorf("%q: parentheses are allowed with in and notin only", term)
+	}
+
+	if key, value, found := strings.Cut(term, "!="); found {
+		return newRequirement(key, NotEquals, []string{value})
+	}
+
+	if key, value, found := strings.Cut(term, "=="); found {
+		return newRequirement(key, Equals, []string{value})
+	}
+
+	if key, value, found := strings.Cut(term, "="); found {
+		return newRequirement(key, Equals, []string{value})
+	}
+
+	if strings.HasPrefix(term, "!") {
+		return newRequirement(strings.TrimPrefix(term, "!"), DoesNotExist, nil)
+	}
+
+	return newRequirement(term, Exists, nil)
+}
+
+func newRequirement(key string, operator Operator, values []string) (Requirement, error) {
+	key = strings.TrimSpace(key)
+	if !keyPattern.MatchString(key) {
+		return Requirement{}, fmt.Errorf("invalid label key %q", key)
+	}
+
+	values, err := parseValues(values)
+	if err != nil {
+		return Requirement{}, err
+	}
+
+	return Requirement{Key: key, Operator: operator, Values: values}, nil
+}
+
+func parseValues(values []string) ([]string, error) {
+	if values == nil {
+		return nil, nil
+	}
+
+	parsed := make([]string, 0, len(values))
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if strings.ContainsAny(value, "=!") {
+			return nil, fmt.Errorf("invalid label value %q", value)
+		}
+
+		parsed = append(parsed, value)
+	}
+
+	return parsed, nil
+}
+
+// split splits the selector by commas outside of parentheses
+func split(selector string) ([]string, error) {
+	if strings.TrimSpace(selector) == "" {
+		return nil, nil
+	}
+
+	var terms []string
+	depth, start := 0, 0
+	for i, r := range selector {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("invalid selector %q: unbalanced parentheses", selector)
+			}
+		case ',':
+			if depth == 0 {
+				terms = append(terms, selector[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("invalid selector %q: unbalanced parentheses", selector)
+	}
+	terms = append(terms, selector[start:])
+
+	for i, term := range terms {
+		terms[i] = strings.TrimSpace(term)
+		if terms[i] == "" {
+			return nil, fmt.Errorf("invalid selector %q: %w", selector, ErrEmptyRequirement)
+		}
+	}
+
+	return terms, nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,73 @@
+package selector
+
+import "testing"
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		selector string
+		want     string
+	}{
+		{"", ""},
+		{"env=prod", "env=prod"},
+		{"env==prod", "env=prod"},
+		{" env = prod , team!=infra ", "env=prod,team!=infra"},
+		{"app in (web, api)", "app in (api,web)"},
+		{"app notin (api),owner,!temp", "app notin (api),owner,!temp"},
+		{"example.com/tier=", "example.com/tier="},
+	}
+
+	for _, tt := range tests {
+		selector, err := Parse(tt.selector)
+		if err != nil {
+			t.Errorf("Parse(%q) error = %v", tt.selector, err)
+			continue
+		}
+		if got := selector.String(); got != tt.want {
+			t.Errorf("Parse(%q) = %q, want %q", tt.selector, got, tt.want)
+		}
+	}
+
+	for _, invalid := range []string{
+		",", "env=prod,", "app in ()", "app in (api", "app in api)", "env=a=b", "!", "bad key", "a\"b", "(env)",
+	} {
+		if selector, err := Parse(invalid); err == nil {
+			t.Errorf("Parse(%q) = %q, want error", invalid, selector)
+		}
+	}
+}
+
+func TestMatches(t *testing.T) {
+	labels := map[string]string{"env": "prod", "team": "web", "app": "api"}
+
+	tests := []struct {
+		selector string
+		want     bool
+	}{
+		{"", true},
+		{"env=prod", true},
+		{"env=dev", false},
+		{"env!=dev", true},
+		{"missing!=dev", true},
+		{"app in (api,web)", true},
+		{"app in (db)", false},
+		{"missing in (db)", false},
+		{"app notin (db)", true},
+		{"missing notin (db)", true},
+		{"team", true},
+		{"missing", false},
+		{"!missing", true},
+		{"!team", false},
+		{"env=prod,team!=infra,app in (api,web)", true},
+		{"env=prod,team=infra", false},
+	}
+
+	for _, tt := range tests {
+		selector, err := Parse(tt.selector)
+		if err != nil {
+			t.Fatalf("Parse(%q) error = %v", tt.selector, err)
+		}
+		if got := selector.Matches(labels); got != tt.want {
+			t.Errorf("Parse(%q).Matches() = %v, want %v", tt.selector, got, tt.want)
+		}
+	}
+}
@@ -80,7 +80,9 @@ func (s *server) authStreamInterceptor(
 func (s *server) authorize(ctx context.Context, method string) error {
 	log.Debug().Msg(method)
 
-	if method == "/proto.Login/RegisterAccount" || method == "/proto.Login/Login" {
+	// Login methods check account credentials themselves
+	switch method {
+	case "/proto.Login/RegisterAccount", "/proto.Login/Login", "/proto.Login/UpdateVaultKey":
 		return nil
 	}
 
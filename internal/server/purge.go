@@ -0,0 +1,35 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-rfe/gpwd/internal/logging/log"
+)
+
+const purgeInterval = time.Hour
+
+// purgeWorker permanently removes secrets deleted longer than TrashRetention ago
+func (s *server) purgeWorker(ctx context.Context) {
+	if s.cfg.TrashRetention <= 0 {
+		return
+	}
+
+	purgeTicker := time.NewTicker(purgeInterval)
+	defer purgeTicker.Stop()
+
+	for {
+		purged, err := s.secretsStorage.PurgeSecrets(ctx, time.Now().Add(-s.cfg.TrashRetention))
+		if err != nil {
+			log.Error().Err(err).Msg("a error occurred during purge deleted secrets")
+		} else if purged > 0 {
+			log.Info().Msgf("Purged %d deleted secrets", purged)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-purgeTicker.C:
+		}
+	}
+}
@@ -6,8 +6,11 @@ import (
 	"io"
 
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
 )
 
 func (s *server) RegisterAccount(ctx context.Context, request *pb.RegisterAccountRequest) (*pb.RegisterAccountResponse, error) {
@@ -19,7 +22,10 @@ func (s *server) RegisterAccount(ctx context.Context, request *pb.RegisterAccoun
 		return nil, err
 	}
 
-	err = s.accountStorage.CreateAccount(ctx, auth)
+	err = s.accountStorage.CreateAccount(ctx, auth, request.GetVaultKey())
+	if errors.Is(err, cloud.ErrAccountExists) {
+		return nil, status.Error(codes.AlreadyExists, err.Error())
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -38,12 +44,12 @@ func (s *server) RegisterAccount(ctx context.Context, request *pb.RegisterAccoun
 func (s *server) Login(ctx context.Context, request *pb.LoginRequest) (*pb.LoginResponse, error) {
 	auth := request.GetAuth()
 
-	existingAuth, err := s.accountStorage.GetByName(ctx, auth.GetUsername())
-	if err != nil {
+	if err := s.checkPassword(ctx, auth); err != nil {
 		return nil, err
 	}
 
-	if err := bcrypt.CompareHashAndPassword(existingAuth.GetPassword(), auth.GetPassword()); err != nil {
+	vaultKey, err := s.accountStorage.GetVaultKey(ctx, auth.GetUsername())
+	if err != nil {
 		return nil, err
 	}
 
@@ -53,102 +59,120 @@ func (s *server) Login(ctx context.Context, request *pb.LoginRequest) (*pb.Login
 	}
 
 	return &pb.LoginResponse{
-		Error: "",
-		Token: token,
+		Error:    "",
+		Token:    token,
+		VaultKey: vaultKey,
 	}, nil
 }
 
-func (s *server) SyncDeleted(stream pb.Sync_SyncDeletedServer) error {
-	var secrets []*pb.Secret
-	for {
-		message, err := stream.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return err
-		}
+// UpdateVaultKey replaces the vault key of the account, the key is opaque to the server
+func (s *server) UpdateVaultKey(ctx context.Context, request *pb.UpdateVaultKeyRequest) (*pb.UpdateVaultKeyResponse, error) {
+	auth := request.GetAuth()
 
-		secrets = append(secrets, message.GetSecret())
+	if err := s.checkPassword(ctx, auth); err != nil {
+		return nil, err
 	}
 
-	username := s.mustReturnUsernameFromContext(stream.Context())
-
-	auth := &pb.Auth{
-		Username: username,
+	if err := s.accountStorage.UpdateVaultKey(ctx, auth.GetUsername(), request.GetVaultKey()); err != nil {
+		return nil, err
 	}
 
-	err := s.secretsStorage.DeleteSecrets(stream.Context(), auth, secrets)
+	return &pb.UpdateVaultKeyResponse{Error: ""}, nil
+}
+
+// checkPassword compares the password of the request with the stored hash of the account
+func (s *server) checkPassword(ctx context.Context, auth *pb.Auth) error {
+	existingAuth, err := s.accountStorage.GetByName(ctx, auth.GetUsername())
 	if err != nil {
 		return err
 	}
 
-	return stream.SendAndClose(&pb.SyncResponse{})
+	return bcrypt.CompareHashAndPassword(existingAuth.GetPassword(), auth.GetPassword())
 }
 
-func (s *server) SyncUpdated(stream pb.Sync_SyncUpdatedServer) error {
-	var secrets []*pb.Secret
-	for {
-		message, err := stream.Recv()
-		if errors.Is(err, io.EOF) {
-			break
-		}
-		if err != nil {
-			return err
-		}
-
-		secrets = append(secrets, message.GetSecret())
-	}
-
+// Sync applies pushed changes one by one acknowledging each after commit,
+// then streams secrets changed after the since change sequence of the request without operation
+func (s *server) Sync(stream pb.Sync_SyncServer) error {
 	username := s.mustReturnUsernameFromContext(stream.Context())
-
 	auth := &pb.Auth{
 		Username: username,
 	}
 
-	err := s.secretsStorage.UpdateSecrets(stream.Context(), auth, secrets)
-	if err != nil {
-		return err
-	}
-
-	return stream.SendAndClose(&pb.SyncResponse{})
-}
-
-func (s *server) SyncCreated(stream pb.Sync_SyncCreatedServer) error {
-	var secrets []*pb.Secret
 	for {
-		message, err := stream.Recv()
+		request, err := stream.Recv()
 		if errors.Is(err, io.EOF) {
-			break
+			return nil
 		}
 		if err != nil {
 			return err
 		}
 
-		secrets = append(secrets, message.GetSecret())
-	}
+		if request.GetOperation() == pb.SyncOperation_SYNC_OPERATION_UNSPECIFIED {
+			if err := s.pull(stream, auth, request.GetSince()); err != nil {
+				return err
+			}
+			continue
+		}
 
-	username := s.mustReturnUsernameFromContext(stream.Context())
+		ack, err := s.applyChange(stream.Context(), auth, request)
+		if err != nil {
+			return err
+		}
 
-	auth := &pb.Auth{
-		Username: username,
+		if err := stream.Send(&pb.SyncResponse{Ack: ack}); err != nil {
+			return err
+		}
 	}
+}
+
+// applyChange commits the single change, so acknowledged changes survive a dropped connection.
+// The change retried after its acknowledgement was lost is acknowledged again, the change rejected
+// by the storage fails only its own acknowledgement
+func (s *server) applyChange(ctx context.Context, auth *pb.Auth, request *pb.SyncRequest) (*pb.SyncAck, error) {
+	secret := request.GetSecret()
+	secrets := []*pb.Secret{secret}
 
-	err := s.secretsStorage.CreateSecrets(stream.Context(), auth, secrets)
+	var result *cloud.SyncResult
+	var err error
+	switch request.GetOperation() {
+	case pb.SyncOperation_CREATE:
+		result, err = s.secretsStorage.CreateSecrets(ctx, auth, secrets)
+	case pb.SyncOperation_UPDATE:
+		result, err = s.secretsStorage.UpdateSecrets(ctx, auth, secrets)
+		if err == nil {
+			err = s.secretsStorage.PruneSecretVersions(ctx, auth, s.cfg.VersionsRetention)
+		}
+	case pb.SyncOperation_DELETE:
+		result, err = s.secretsStorage.DeleteSecrets(ctx, auth, secrets)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unknown sync operation %s", request.GetOperation())
+	}
+	if errors.Is(err, cloud.ErrSecretExists) {
+		return &pb.SyncAck{Id: secret.GetID(), Error: err.Error()}, nil
+	}
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return stream.SendAndClose(&pb.SyncResponse{})
-}
+	ack := &pb.SyncAck{
+		Id:       secret.GetID(),
+		Revision: result.Revisions[secret.GetID()],
+	}
+	if len(result.Conflicts) > 0 {
+		ack.Conflict = result.Conflicts[0]
+	}
+	ack.NotFound = len(result.NotFound) > 0
 
-func (s *server) Sync(_ *pb.SyncRequest, stream pb.Sync_SyncServer) error {
-	username := s.mustReturnUsernameFromContext(stream.Context())
-	auth := &pb.Auth{
-		Username: username,
+	if ack.GetRevision() > 0 {
+		s.watchers.notify(auth.GetUsername(), &pb.WatchEvent{Id: secret.GetID()})
 	}
 
-	secrets, err := s.secretsStorage.ListSecrets(stream.Context(), auth)
+	return ack, nil
+}
+
+// pull streams secrets changed after the since change sequence
+func (s *server) pull(stream pb.Sync_SyncServer, auth *pb.Auth, since uint64) error {
+	secrets, cursor, err := s.secretsStorage.ListSecrets(stream.Context(), auth, since)
 	if err != nil {
 		return err
 	}
@@ -156,6 +180,7 @@ func (s *server) Sync(_ *pb.SyncRequest, stream pb.Sync_SyncServer) error {
 	for _, secret := range secrets {
 		if err := stream.Send(&pb.SyncResponse{
 			Secret: secret,
+			Cursor: cursor,
 		}); err != nil {
 			return err
 		}
@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"net"
 	"os"
@@ -18,11 +19,16 @@ import (
 )
 
 type Cfg struct {
-	ServerAddress string        `mapstructure:"server_address"`
-	TokenLifespan time.Duration `mapstructure:"token_lifespan"`
-	DatabaseDSN   string        `mapstructure:"database_dsn"`
-	CertPath      string        `mapstructure:"server_cert_path"`
-	KeyPath       string        `mapstructure:"server_key_path"`
+	ServerAddress     string        `mapstructure:"server_address"`
+	TokenLifespan     time.Duration `mapstructure:"token_lifespan"`
+	DatabaseDSN       string        `mapstructure:"database_dsn"`
+	CertPath          string        `mapstructure:"server_cert_path"`
+	KeyPath           string        `mapstructure:"server_key_path"`
+	VersionsRetention int           `mapstructure:"server_versions_retention"`
+	TrashRetention    time.Duration `mapstructure:"server_trash_retention"`
+
+	// StreamInterceptor runs after authentication, e.g. to inject stream failures in tests
+	StreamInterceptor grpc.StreamServerInterceptor `mapstructure:"-"`
 }
 
 type server struct {
@@ -30,13 +36,15 @@ type server struct {
 	secretKey      []byte
 	accountStorage cloud.Accounts
 	secretsStorage cloud.Secrets
+	watchers       *watchers
 	pb.UnimplementedLoginServer
 	pb.UnimplementedSyncServer
 }
 
 func NewServer(cfg *Cfg) *server {
 	return &server{
-		cfg: cfg,
+		cfg:      cfg,
+		watchers: newWatchers(),
 	}
 }
 
@@ -48,9 +56,21 @@ func (s *server) Run() {
 	)
 	defer stop()
 
-	storage, err := cloud.NewDB(s.cfg.DatabaseDSN)
+	listener, err := s.createListener()
+	if err != nil {
+		log.Fatal().Err(err).Msgf("failed to create listener")
+	}
+
+	if err := s.Serve(ctx, listener); err != nil {
+		log.Fatal().Err(err).Msg("couldn't start server")
+	}
+}
+
+// Serve opens the storage and serves RPCs on the listener until ctx is done
+func (s *server) Serve(ctx context.Context, listener net.Listener) error {
+	storage, err := cloud.Open(s.cfg.DatabaseDSN)
 	if err != nil {
-		log.Fatal().Err(err).Msg("failed to create storage")
+		return fmt.Errorf("failed to create storage: %w", err)
 	}
 	defer func(closer io.Closer) {
 		if err := storage.Close(); err != nil {
@@ -63,17 +83,12 @@ func (s *server) Run() {
 
 	s.secretKey, err = os.ReadFile(s.cfg.KeyPath)
 	if err != nil {
-		log.Fatal().Err(err).Msgf("failed to read TLS key")
+		return fmt.Errorf("failed to read TLS key: %w", err)
 	}
 
-	listener, err := s.createListener()
-	if err != nil {
-		log.Fatal().Err(err).Msgf("failed to create listener")
-	}
+	go s.purgeWorker(ctx)
 
-	if err := s.listenEndServe(ctx, listener); err != nil {
-		log.Fatal().Err(err).Msg("couldn't start server")
-	}
+	return s.listenEndServe(ctx, listener)
 }
 
 func (s *server) createListener() (net.Listener, error) {
@@ -91,10 +106,15 @@ func (s *server) listenEndServe(ctx context.Context, listener net.Listener) erro
 		return err
 	}
 
+	streamInterceptors := []grpc.StreamServerInterceptor{s.authStreamInterceptor}
+	if s.cfg.StreamInterceptor != nil {
+		streamInterceptors = append(streamInterceptors, s.cfg.StreamInterceptor)
+	}
+
 	grpcServer := grpc.NewServer(
 		grpc.Creds(serverTransportCreds),
 		grpc.UnaryInterceptor(s.authUnaryInterceptor),
-		grpc.StreamInterceptor(s.authStreamInterceptor),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
 	)
 
 	pb.RegisterLoginServer(grpcServer, s)
@@ -0,0 +1,78 @@
+package server
+
+import (
+	"sync"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+// watchers fans out change events to Watch streams of the same user within the server process
+type watchers struct {
+	mu          sync.Mutex
+	subscribers map[string]map[chan *pb.WatchEvent]struct{}
+}
+
+func newWatchers() *watchers {
+	return &watchers{
+		subscribers: make(map[string]map[chan *pb.WatchEvent]struct{}),
+	}
+}
+
+// subscribe returns the channel of the user events and the function to unsubscribe
+func (w *watchers) subscribe(username string) (<-chan *pb.WatchEvent, func()) {
+	// A slow watcher misses events, but it syncs all changes on the next one anyway
+	events := make(chan *pb.WatchEvent, 1)
+
+	w.mu.Lock()
+	if w.subscribers[username] == nil {
+		w.subscribers[username] = make(map[chan *pb.WatchEvent]struct{})
+	}
+	w.subscribers[username][events] = struct{}{}
+	w.mu.Unlock()
+
+	return events, func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		delete(w.subscribers[username], events)
+		if len(w.subscribers[username]) == 0 {
+			delete(w.subscribers, username)
+		}
+	}
+}
+
+func (w *watchers) notify(username string, event *pb.WatchEvent) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	for events := range w.subscribers[username] {
+		select {
+		case events <- event:
+		default:
+		}
+	}
+}
+
+// Watch streams change events of the user secrets until the client disconnects
+func (s *server) Watch(_ *pb.WatchRequest, stream pb.Sync_WatchServer) error {
+	username := s.mustReturnUsernameFromContext(stream.Context())
+
+	events, unsubscribe := s.watchers.subscribe(username)
+	defer unsubscribe()
+
+	// Confirm the subscription, changes committed after it are not missed
+	if err := stream.Send(&pb.WatchEvent{}); err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event := <-events:
+			if err := stream.Send(event); err != nil {
+				return err
+			}
+		}
+	}
+}
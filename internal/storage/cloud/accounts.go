@@ -2,8 +2,11 @@ package cloud
 
 import (
 	"context"
+	"database/sql"
 	"errors"
 
+	"google.golang.org/protobuf/proto"
+
 	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
@@ -13,6 +16,47 @@ var (
 )
 
 type Accounts interface {
-	CreateAccount(ctx context.Context, auth *pb.Auth) error
+	// CreateAccount creates the account with the vault key shared by its devices, the key may be nil
+	CreateAccount(ctx context.Context, auth *pb.Auth, vaultKey *pb.VaultKey) error
 	GetByName(ctx context.Context, username string) (*pb.Auth, error)
+	// GetVaultKey returns the vault key of the account, nil if the account has no key yet
+	GetVaultKey(ctx context.Context, username string) (*pb.VaultKey, error)
+	UpdateVaultKey(ctx context.Context, username string, vaultKey *pb.VaultKey) error
+}
+
+// MarshalVaultKey serializes the vault key for storage, the nil key gives nil
+func MarshalVaultKey(vaultKey *pb.VaultKey) ([]byte, error) {
+	if vaultKey == nil {
+		return nil, nil
+	}
+
+	return proto.Marshal(vaultKey)
+}
+
+// UnmarshalVaultKey restores the vault key serialized with MarshalVaultKey
+func UnmarshalVaultKey(data []byte) (*pb.VaultKey, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	vaultKey := &pb.VaultKey{}
+	if err := proto.Unmarshal(data, vaultKey); err != nil {
+		return nil, err
+	}
+
+	return vaultKey, nil
+}
+
+// AccountUpdated returns ErrAccountNotFound if the update of the account changed no rows
+func AccountUpdated(result sql.Result) error {
+	updated, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if updated == 0 {
+		return ErrAccountNotFound
+	}
+
+	return nil
 }
@@ -0,0 +1,529 @@
+// Package cloudtest implements the conformance suite every server storage backend must pass.
+// Every test works with its own users, so the suite can run against a shared database
+package cloudtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
+)
+
+// Opener returns a store with the schema applied, the store is closed by the suite
+type Opener func(t *testing.T) cloud.Storage
+
+// Run runs the conformance suite against stores returned by open
+func Run(t *testing.T, open Opener) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, ctx context.Context, storage cloud.Storage)
+	}{
+		{"Accounts", testAccounts},
+		{"VaultKey", testVaultKey},
+		{"CreateSecrets", testCreateSecrets},
+		{"UpdateSecrets", testUpdateSecrets},
+		{"DeleteSecrets", testDeleteSecrets},
+		{"ListSecrets", testListSecrets},
+		{"PurgeSecrets", testPurgeSecrets},
+		{"PruneSecretVersions", testPruneSecretVersions},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			storage := open(t)
+			t.Cleanup(func() {
+				if err := storage.Close(); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			})
+
+			tt.test(t, context.Background(), storage)
+		})
+	}
+}
+
+func newAccount(t *testing.T, ctx context.Context, storage cloud.Storage) *pb.Auth {
+	t.Helper()
+
+	auth := &pb.Auth{
+		Username: "user-" + uuid.NewString(),
+		Password: []byte("password hash"),
+	}
+	if err := storage.CreateAccount(ctx, auth, nil); err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+
+	return auth
+}
+
+func newSecret() *pb.Secret {
+	return &pb.Secret{
+		ID:        uuid.NewString(),
+		Labels:    map[string]string{"site": "example.com"},
+		CreatedAt: timestamppb.New(time.Unix(1600000000, 0)),
+		Data:      []byte("data"),
+		Status:    &pb.Status{},
+	}
+}
+
+func newLogin() *pb.Secret {
+	secret := newSecret()
+	secret.Data = nil
+	secret.Payload = &pb.Secret_Login{Login: &pb.LoginPayload{
+		Username: "user",
+		Password: []byte("password"),
+		URIs:     []string{"https://example.com"},
+	}}
+
+	return secret
+}
+
+func apply(
+	t *testing.T,
+	what string,
+	change func(context.Context, *pb.Auth, []*pb.Secret) (*cloud.SyncResult, error),
+	ctx context.Context,
+	auth *pb.Auth,
+	secrets ...*pb.Secret,
+) *cloud.SyncResult {
+	t.Helper()
+
+	result, err := change(ctx, auth, secrets)
+	if err != nil {
+		t.Fatalf("%s() error = %v", what, err)
+	}
+
+	return result
+}
+
+func list(t *testing.T, ctx context.Context, storage cloud.Storage, auth *pb.Auth, since uint64) ([]*pb.Secret, uint64) {
+	t.Helper()
+
+	secrets, cursor, err := storage.ListSecrets(ctx, auth, since)
+	if err != nil {
+		t.Fatalf("ListSecrets() error = %v", err)
+	}
+
+	return secrets, cursor
+}
+
+// current returns the current state of the secret, it fails if the secret isn't listed
+func current(t *testing.T, ctx context.Context, storage cloud.Storage, auth *pb.Auth, id string) *pb.Secret {
+	t.Helper()
+
+	secrets, _ := list(t, ctx, storage, auth, 0)
+	for _, secret := range secrets {
+		if secret.GetID() == id {
+			return secret
+		}
+	}
+
+	t.Fatalf("ListSecrets() has no secret %s", id)
+
+	return nil
+}
+
+func listed(t *testing.T, ctx context.Context, storage cloud.Storage, auth *pb.Auth, id string) bool {
+	t.Helper()
+
+	secrets, _ := list(t, ctx, storage, auth, 0)
+	for _, secret := range secrets {
+		if secret.GetID() == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func assertEqual(t *testing.T, what string, want, got proto.Message) {
+	t.Helper()
+
+	if !proto.Equal(want, got) {
+		t.Errorf("%s = %v, want %v", what, got, want)
+	}
+}
+
+func assertRevision(t *testing.T, what string, result *cloud.SyncResult, id string, want uint64) {
+	t.Helper()
+
+	if got := result.Revisions[id]; got != want {
+		t.Errorf("%s revision = %d, want %d", what, got, want)
+	}
+}
+
+func assertNotFound(t *testing.T, what string, want []string, result *cloud.SyncResult) {
+	t.Helper()
+
+	if len(result.NotFound) != len(want) {
+		t.Fatalf("%s not found = %v, want %v", what, result.NotFound, want)
+	}
+	for i := range want {
+		if result.NotFound[i] != want[i] {
+			t.Fatalf("%s not found = %v, want %v", what, result.NotFound, want)
+		}
+	}
+}
+
+func testAccounts(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+
+	if err := storage.CreateAccount(ctx, auth, nil); !errors.Is(err, cloud.ErrAccountExists) {
+		t.Errorf("CreateAccount() of existing account error = %v, want %v", err, cloud.ErrAccountExists)
+	}
+
+	got, err := storage.GetByName(ctx, auth.GetUsername())
+	if err != nil {
+		t.Fatalf("GetByName() error = %v", err)
+	}
+	assertEqual(t, "GetByName()", auth, got)
+
+	if _, err := storage.GetByName(ctx, "missing-"+uuid.NewString()); !errors.Is(err, cloud.ErrAccountNotFound) {
+		t.Errorf("GetByName(missing) error = %v, want %v", err, cloud.ErrAccountNotFound)
+	}
+}
+
+func testVaultKey(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	vaultKey := &pb.VaultKey{
+		Kdf:        "argon2id",
+		Salt:       []byte("salt"),
+		Time:       1,
+		Memory:     1024,
+		Threads:    1,
+		Verifier:   []byte("verifier"),
+		WrappedKey: []byte("wrapped"),
+	}
+
+	auth := &pb.Auth{
+		Username: "user-" + uuid.NewString(),
+		Password: []byte("password hash"),
+	}
+	if err := storage.CreateAccount(ctx, auth, vaultKey); err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+
+	got, err := storage.GetVaultKey(ctx, auth.GetUsername())
+	if err != nil {
+		t.Fatalf("GetVaultKey() error = %v", err)
+	}
+	assertEqual(t, "GetVaultKey()", vaultKey, got)
+
+	vaultKey.WrappedKey = []byte("rewrapped")
+	vaultKey.RecoveryKey = []byte("recovery")
+	if err := storage.UpdateVaultKey(ctx, auth.GetUsername(), vaultKey); err != nil {
+		t.Fatalf("UpdateVaultKey() error = %v", err)
+	}
+
+	got, err = storage.GetVaultKey(ctx, auth.GetUsername())
+	if err != nil {
+		t.Fatalf("GetVaultKey() error = %v", err)
+	}
+	assertEqual(t, "GetVaultKey() after update", vaultKey, got)
+
+	// Accounts registered before vault keys were shared have none
+	withoutKey := newAccount(t, ctx, storage)
+	if got, err := storage.GetVaultKey(ctx, withoutKey.GetUsername()); err != nil || got != nil {
+		t.Errorf("GetVaultKey() of account without key = %v, %v, want nil", got, err)
+	}
+
+	missing := "missing-" + uuid.NewString()
+	if _, err := storage.GetVaultKey(ctx, missing); !errors.Is(err, cloud.ErrAccountNotFound) {
+		t.Errorf("GetVaultKey(missing) error = %v, want %v", err, cloud.ErrAccountNotFound)
+	}
+	if err := storage.UpdateVaultKey(ctx, missing, vaultKey); !errors.Is(err, cloud.ErrAccountNotFound) {
+		t.Errorf("UpdateVaultKey(missing) error = %v, want %v", err, cloud.ErrAccountNotFound)
+	}
+}
+
+func testCreateSecrets(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+
+	data := newSecret()
+	login := newLogin()
+	result := apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, data, login)
+	assertRevision(t, "CreateSecrets(data)", result, data.GetID(), 1)
+	assertRevision(t, "CreateSecrets(login)", result, login.GetID(), 1)
+
+	// Secrets are created with the first revision
+	for _, secret := range []*pb.Secret{data, login} {
+		want := proto.Clone(secret).(*pb.Secret)
+		want.Revision = 1
+		assertEqual(t, "ListSecrets() created secret", want, current(t, ctx, storage, auth, secret.GetID()))
+	}
+
+	// The create retried after its acknowledgement was lost is acknowledged with the current revision
+	result = apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, data)
+	assertRevision(t, "CreateSecrets() retried", result, data.GetID(), 1)
+	if len(result.Conflicts) != 0 {
+		t.Errorf("CreateSecrets() retried conflicts = %v, want none", result.Conflicts)
+	}
+
+	// The secret with the same ID and another content is reported as a conflict
+	want := proto.Clone(data).(*pb.Secret)
+	want.Revision = 1
+	another := newSecretWithID(data.GetID())
+	another.Data = []byte("another")
+	result = apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, another)
+	assertRevision(t, "CreateSecrets() of existing secret", result, data.GetID(), 0)
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("CreateSecrets() of existing secret conflicts = %v, want one", result.Conflicts)
+	}
+	assertEqual(t, "CreateSecrets() conflict", want, result.Conflicts[0])
+
+	// The ID taken by another user fails the batch, the batch is applied atomically
+	other := newAccount(t, ctx, storage)
+	created := newSecret()
+	if _, err := storage.CreateSecrets(ctx, other, []*pb.Secret{created, newSecretWithID(data.GetID())}); !errors.Is(err, cloud.ErrSecretExists) {
+		t.Fatalf("CreateSecrets() of secret of other user error = %v, want %v", err, cloud.ErrSecretExists)
+	}
+	if listed(t, ctx, storage, other, created.GetID()) {
+		t.Errorf("ListSecrets() has secret %s of the failed batch", created.GetID())
+	}
+	assertEqual(t, "ListSecrets() secret created by other user", want, current(t, ctx, storage, auth, data.GetID()))
+}
+
+func newSecretWithID(id string) *pb.Secret {
+	secret := newSecret()
+	secret.ID = id
+
+	return secret
+}
+
+func testUpdateSecrets(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+
+	secret := newLogin()
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, secret)
+
+	updated := proto.Clone(secret).(*pb.Secret)
+	updated.Revision = 1
+	updated.UpdatedAt = timestamppb.New(time.Unix(1600000100, 0))
+	updated.Labels = map[string]string{"site": "example.org"}
+	updated.GetLogin().Password = []byte("changed")
+
+	result := apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, updated)
+	assertRevision(t, "UpdateSecrets()", result, secret.GetID(), 2)
+	if len(result.Conflicts) != 0 {
+		t.Errorf("UpdateSecrets() conflicts = %v, want none", result.Conflicts)
+	}
+
+	want := proto.Clone(updated).(*pb.Secret)
+	want.Revision = 2
+	assertEqual(t, "ListSecrets() updated secret", want, current(t, ctx, storage, auth, secret.GetID()))
+
+	// The update retried after its acknowledgement was lost is acknowledged with the current revision
+	result = apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, updated)
+	assertRevision(t, "UpdateSecrets() retried", result, secret.GetID(), 2)
+	if len(result.Conflicts) != 0 {
+		t.Errorf("UpdateSecrets() retried conflicts = %v, want none", result.Conflicts)
+	}
+	assertEqual(t, "ListSecrets() secret after retried update", want, current(t, ctx, storage, auth, secret.GetID()))
+
+	// The update made over a stale revision is rejected with the current state of the secret
+	stale := proto.Clone(updated).(*pb.Secret)
+	stale.Data = []byte("stale")
+	result = apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, stale)
+	assertRevision(t, "UpdateSecrets() of stale secret", result, secret.GetID(), 0)
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("UpdateSecrets() of stale secret conflicts = %v, want one", result.Conflicts)
+	}
+	assertEqual(t, "UpdateSecrets() conflict", want, result.Conflicts[0])
+
+	// Missing secrets and secrets of other users aren't updated but reported as not found
+	other := newAccount(t, ctx, storage)
+	missing := newSecret()
+	missing.Revision = 1
+	result = apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, other, missing, want)
+	if len(result.Revisions) != 0 || len(result.Conflicts) != 0 {
+		t.Errorf("UpdateSecrets() of missing secrets = %+v, want no revisions and conflicts", result)
+	}
+	assertNotFound(t, "UpdateSecrets() of missing secrets", []string{missing.GetID(), want.GetID()}, result)
+	assertEqual(t, "ListSecrets() secret updated by other user", want, current(t, ctx, storage, auth, secret.GetID()))
+}
+
+func testDeleteSecrets(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+
+	secret := newSecret()
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, secret)
+
+	deleted := proto.Clone(secret).(*pb.Secret)
+	deleted.Revision = 1
+	deleted.DeletedAt = timestamppb.New(time.Unix(1600000200, 0))
+	deleted.Status.Deleted = true
+
+	result := apply(t, "DeleteSecrets", storage.DeleteSecrets, ctx, auth, deleted)
+	assertRevision(t, "DeleteSecrets()", result, secret.GetID(), 2)
+
+	// The content is kept in the trash
+	want := proto.Clone(deleted).(*pb.Secret)
+	want.Revision = 2
+	assertEqual(t, "ListSecrets() deleted secret", want, current(t, ctx, storage, auth, secret.GetID()))
+
+	// The deletion retried after its acknowledgement was lost is acknowledged with the current revision
+	result = apply(t, "DeleteSecrets", storage.DeleteSecrets, ctx, auth, deleted)
+	assertRevision(t, "DeleteSecrets() retried", result, secret.GetID(), 2)
+	if len(result.Conflicts) != 0 {
+		t.Errorf("DeleteSecrets() retried conflicts = %v, want none", result.Conflicts)
+	}
+
+	// Updating the deleted secret moves it out of the trash
+	restored := proto.Clone(secret).(*pb.Secret)
+	restored.Revision = 2
+	restored.UpdatedAt = timestamppb.New(time.Unix(1600000300, 0))
+
+	result = apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, restored)
+	assertRevision(t, "UpdateSecrets() of deleted secret", result, secret.GetID(), 3)
+
+	want = proto.Clone(restored).(*pb.Secret)
+	want.Revision = 3
+	assertEqual(t, "ListSecrets() restored secret", want, current(t, ctx, storage, auth, secret.GetID()))
+
+	// The deletion made over a stale revision is rejected with the current state of the secret
+	result = apply(t, "DeleteSecrets", storage.DeleteSecrets, ctx, auth, deleted)
+	assertRevision(t, "DeleteSecrets() of stale secret", result, secret.GetID(), 0)
+	if len(result.Conflicts) != 1 {
+		t.Fatalf("DeleteSecrets() of stale secret conflicts = %v, want one", result.Conflicts)
+	}
+	assertEqual(t, "DeleteSecrets() conflict", want, result.Conflicts[0])
+
+	// The deletion of the missing secret is reported as not found
+	missing := proto.Clone(deleted).(*pb.Secret)
+	missing.ID = uuid.NewString()
+	result = apply(t, "DeleteSecrets", storage.DeleteSecrets, ctx, auth, missing)
+	if len(result.Revisions) != 0 || len(result.Conflicts) != 0 {
+		t.Errorf("DeleteSecrets() of missing secret = %+v, want no revisions and conflicts", result)
+	}
+	assertNotFound(t, "DeleteSecrets() of missing secret", []string{missing.GetID()}, result)
+}
+
+func testListSecrets(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+	other := newAccount(t, ctx, storage)
+
+	secrets, cursor := list(t, ctx, storage, auth, 0)
+	if len(secrets) != 0 || cursor != 0 {
+		t.Fatalf("ListSecrets() of new user = %v, %d, want none", secrets, cursor)
+	}
+
+	first, second := newSecret(), newSecret()
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, first)
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, other, newSecret())
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, second)
+
+	// Secrets of other users aren't listed
+	secrets, cursor = list(t, ctx, storage, auth, 0)
+	assertIDs(t, "ListSecrets(0)", []string{first.GetID(), second.GetID()}, secrets)
+	if cursor == 0 {
+		t.Fatalf("ListSecrets(0) cursor = 0, want the sequence of the last change")
+	}
+
+	secrets, next := list(t, ctx, storage, auth, cursor)
+	if len(secrets) != 0 || next != cursor {
+		t.Errorf("ListSecrets(cursor) = %v, %d, want none and the same cursor %d", secrets, next, cursor)
+	}
+
+	// Changed secrets are listed in the order of changes
+	first.Revision = 1
+	first.Data = []byte("changed")
+	first.UpdatedAt = timestamppb.New(time.Unix(1600000400, 0))
+	apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, first)
+
+	secrets, next = list(t, ctx, storage, auth, cursor)
+	assertIDs(t, "ListSecrets(cursor) after update", []string{first.GetID()}, secrets)
+	if next <= cursor {
+		t.Errorf("ListSecrets(cursor) after update cursor = %d, want greater than %d", next, cursor)
+	}
+
+	secrets, _ = list(t, ctx, storage, auth, 0)
+	assertIDs(t, "ListSecrets(0) after update", []string{second.GetID(), first.GetID()}, secrets)
+}
+
+func assertIDs(t *testing.T, what string, want []string, secrets []*pb.Secret) {
+	t.Helper()
+
+	got := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		got = append(got, secret.GetID())
+	}
+
+	if len(got) != len(want) {
+		t.Fatalf("%s = %v, want %v", what, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s = %v, want %v", what, got, want)
+		}
+	}
+}
+
+func testPurgeSecrets(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+
+	purged, recent, alive := newSecret(), newSecret(), newSecret()
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, purged, recent, alive)
+
+	// The content is archived to check the history is purged as well
+	purged.Revision = 1
+	purged.Data = []byte("changed")
+	apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, purged)
+
+	purged.Revision = 2
+	purged.DeletedAt = timestamppb.New(time.Unix(1000000000, 0))
+	recent.Revision = 1
+	recent.DeletedAt = timestamppb.Now()
+	apply(t, "DeleteSecrets", storage.DeleteSecrets, ctx, auth, purged, recent)
+
+	count, err := storage.PurgeSecrets(ctx, time.Unix(1000000001, 0))
+	if err != nil {
+		t.Fatalf("PurgeSecrets() error = %v", err)
+	}
+	if count < 1 {
+		t.Errorf("PurgeSecrets() = %d, want at least 1", count)
+	}
+
+	if listed(t, ctx, storage, auth, purged.GetID()) {
+		t.Errorf("ListSecrets() has purged secret")
+	}
+	for _, secret := range []*pb.Secret{recent, alive} {
+		if !listed(t, ctx, storage, auth, secret.GetID()) {
+			t.Errorf("ListSecrets() has no secret %s kept by purge", secret.GetID())
+		}
+	}
+
+	// The purged secret can be created again
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, newSecretWithID(purged.GetID()))
+}
+
+func testPruneSecretVersions(t *testing.T, ctx context.Context, storage cloud.Storage) {
+	auth := newAccount(t, ctx, storage)
+
+	secret := newSecret()
+	apply(t, "CreateSecrets", storage.CreateSecrets, ctx, auth, secret)
+
+	for i := 1; i <= 3; i++ {
+		secret.Revision = uint64(i)
+		secret.Data = []byte{byte('0' + i)}
+		apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, secret)
+	}
+
+	for _, keep := range []int{0, 2, 1} {
+		if err := storage.PruneSecretVersions(ctx, auth, keep); err != nil {
+			t.Fatalf("PruneSecretVersions(%d) error = %v", keep, err)
+		}
+	}
+
+	// Versions are archived with increasing numbers after pruning
+	secret.Revision = 4
+	secret.Data = []byte("after prune")
+	result := apply(t, "UpdateSecrets", storage.UpdateSecrets, ctx, auth, secret)
+	assertRevision(t, "UpdateSecrets() after prune", result, secret.GetID(), 5)
+}
@@ -10,27 +10,53 @@ import (
 
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	migratepgx "github.com/golang-migrate/migrate/v4/database/pgx"
 	"github.com/jackc/pgconn"
 	_ "github.com/jackc/pgx/v4/stdlib" // init postgresql driver
 
+	"github.com/go-rfe/gpwd/db/migrations"
 	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/payload"
 	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
 const (
 	psqlDriverName           = "pgx"
 	pgErrCodeUniqueViolation = "23505"
+
+	postgresScheme = "postgres"
 )
 
 var (
-	_ Accounts = (*DB)(nil)
+	_ Storage = (*DB)(nil)
 )
 
 type DB struct {
 	conn *sql.DB
 }
 
+func init() {
+	for _, scheme := range []string{postgresScheme, "postgresql"} {
+		Register(scheme, func(dsn string) (Storage, error) {
+			return NewDB(dsn)
+		})
+		RegisterMigration(scheme, NewPostgresMigration)
+	}
+}
+
+// NewDB opens the PostgreSQL store and applies pending schema migrations
 func NewDB(databaseDSN string) (*DB, error) {
+	migration, err := NewPostgresMigration(databaseDSN)
+	if err != nil {
+		return nil, err
+	}
+
+	err = migration.Up()
+	closeObject(migration)
+	if err != nil {
+		return nil, err
+	}
+
 	var db DB
 	conn, err := sql.Open(psqlDriverName, databaseDSN)
 	if err != nil {
@@ -43,12 +69,34 @@ func NewDB(databaseDSN string) (*DB, error) {
 	return &db, nil
 }
 
-func (db *DB) CreateAccount(ctx context.Context, auth *pb.Auth) error {
+// NewPostgresMigration opens schema migrations of the PostgreSQL store,
+// migrations are applied under the advisory lock of the database
+func NewPostgresMigration(databaseDSN string) (*Migration, error) {
+	conn, err := sql.Open(psqlDriverName, databaseDSN)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := migratepgx.WithInstance(conn, &migratepgx.Config{})
+	if err != nil {
+		closeObject(conn)
+		return nil, err
+	}
+
+	return NewMigration(migrations.Server, "server", psqlDriverName, driver)
+}
+
+func (db *DB) CreateAccount(ctx context.Context, auth *pb.Auth, vaultKey *pb.VaultKey) error {
 	var pgErr *pgconn.PgError
 
-	_, err := db.conn.ExecContext(ctx, `
-		INSERT INTO accounts (username, password) VALUES ($1, $2);`,
-		auth.GetUsername(), auth.GetPassword(),
+	key, err := MarshalVaultKey(vaultKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.conn.ExecContext(ctx, `
+		INSERT INTO accounts (username, password, vault_key) VALUES ($1, $2, $3);`,
+		auth.GetUsername(), auth.GetPassword(), key,
 	)
 
 	if err != nil && errors.As(err, &pgErr) && pgErr.Code == pgErrCodeUniqueViolation {
@@ -78,194 +126,427 @@ func (db *DB) GetByName(ctx context.Context, username string) (*pb.Auth, error)
 	}, nil
 }
 
-func (db *DB) CreateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) error {
-	tx, err := db.conn.BeginTx(ctx, nil)
+func (db *DB) GetVaultKey(ctx context.Context, username string) (*pb.VaultKey, error) {
+	var key []byte
+	err := db.conn.QueryRowContext(ctx,
+		"SELECT vault_key FROM accounts WHERE username = $1", username).Scan(&key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return UnmarshalVaultKey(key)
+}
+
+func (db *DB) UpdateVaultKey(ctx context.Context, username string, vaultKey *pb.VaultKey) error {
+	key, err := MarshalVaultKey(vaultKey)
 	if err != nil {
 		return err
 	}
+
+	result, err := db.conn.ExecContext(ctx,
+		"UPDATE accounts SET vault_key = $1 WHERE username = $2", key, username)
+	if err != nil {
+		return err
+	}
+
+	return AccountUpdated(result)
+}
+
+// CreateSecrets creates secrets with the first revision, the secret of the user which already exists
+// is acknowledged if it has the labels and content of the created one, otherwise it's reported as a conflict
+func (db *DB) CreateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error) {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
 	defer rollbackTx(tx)
 
+	if err := lockAccount(ctx, tx, auth); err != nil {
+		return nil, err
+	}
+
 	stmtCreateSecret, err := tx.Prepare(
 		`INSERT INTO secrets
-    		  (id, username, labels, created_at, data) 
-			  VALUES ($1, $2, $3, $4, $5)`,
+    		  (id, username, labels, created_at, data, payload, revision) 
+			  VALUES ($1, $2, $3, $4, $5, $6, 1)`,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer closeObject(stmtCreateSecret)
 
+	result := NewSyncResult()
 	for _, secret := range secrets {
+		var owner string
+		err := tx.QueryRowContext(ctx, `SELECT username FROM secrets WHERE id=$1;`, secret.GetID()).Scan(&owner)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return nil, err
+		case owner != auth.GetUsername():
+			return nil, ErrSecretExists
+		default:
+			if err := result.AddRejectedRow(getConflict(ctx, tx, auth, secret.GetID()), secret, HasUpdate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
 		var metadata []byte
 		if secret.Labels != nil {
 			metadata, err = json.Marshal(secret.GetLabels())
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 
+		secretPayload, err := payload.Marshal(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		var pgErr *pgconn.PgError
 		if _, err := stmtCreateSecret.Exec(
 			secret.GetID(), auth.GetUsername(),
 			metadata, secret.GetCreatedAt().AsTime(),
-			secret.GetData(),
+			secret.GetData(), secretPayload,
 		); err != nil {
-			return err
+			// The ID is taken by another user concurrently
+			if errors.As(err, &pgErr) && pgErr.Code == pgErrCodeUniqueViolation {
+				return nil, ErrSecretExists
+			}
+			return nil, err
 		}
+
+		result.Revisions[secret.GetID()] = 1
 	}
 
 	if err := tx.Commit(); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
 }
 
-func (db *DB) UpdateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) error {
+// UpdateSecrets updates secrets content, updated secrets are moved out of the trash.
+// The secret is updated only if its revision matches the current one, otherwise it's reported as a conflict
+func (db *DB) UpdateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error) {
 	tx, err := db.conn.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer rollbackTx(tx)
 
+	if err := lockAccount(ctx, tx, auth); err != nil {
+		return nil, err
+	}
+
+	// The current revision is archived if data or payload changes
+	stmtArchiveSecret, err := tx.Prepare(
+		`INSERT INTO secret_versions
+    		  (secret_id, username, version, labels, created_at, updated_at, data, payload, archived_at)
+			  SELECT id, username,
+			  COALESCE((SELECT MAX(version) FROM secret_versions WHERE secret_id=$1 AND username=$2), 0) + 1,
+			  labels, created_at, updated_at, data, payload, now()
+			  FROM secrets
+			  WHERE id=$1 AND username=$2 AND revision=$3 AND deleted=false
+			  AND (data IS DISTINCT FROM $4 OR payload IS DISTINCT FROM $5)`,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer closeObject(stmtArchiveSecret)
+
 	stmtUpdateSecret, err := tx.Prepare(
 		`UPDATE secrets set
-    		  labels=$3, updated_at=$4, data=$5 
-			  WHERE id=$1 AND username=$2`,
+    		  labels=$4, updated_at=$5, data=$6, payload=$7, deleted=false, deleted_at=NULL, revision=revision+1,
+    		  change_seq=nextval('secrets_change_seq') 
+			  WHERE id=$1 AND username=$2 AND revision=$3
+			  RETURNING revision`,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer closeObject(stmtUpdateSecret)
 
+	result := NewSyncResult()
 	for _, secret := range secrets {
 		var metadata []byte
 		if secret.Labels != nil {
 			metadata, err = json.Marshal(secret.GetLabels())
 			if err != nil {
-				return err
+				return nil, err
 			}
 		}
 
-		if _, err := stmtUpdateSecret.Exec(
-			secret.GetID(), auth.GetUsername(),
-			metadata, secret.GetUpdatedAt().AsTime(),
-			secret.GetData(),
+		secretPayload, err := payload.Marshal(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := stmtArchiveSecret.Exec(
+			secret.GetID(), auth.GetUsername(), secret.GetRevision(),
+			secret.GetData(), secretPayload,
 		); err != nil {
-			return err
+			return nil, err
+		}
+
+		var revision uint64
+		err = stmtUpdateSecret.QueryRow(
+			secret.GetID(), auth.GetUsername(), secret.GetRevision(),
+			metadata, secret.GetUpdatedAt().AsTime(),
+			secret.GetData(), secretPayload,
+		).Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			if err := result.AddRejectedRow(getConflict(ctx, tx, auth, secret.GetID()), secret, HasUpdate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
 		}
+
+		result.Revisions[secret.GetID()] = revision
 	}
 
 	if err := tx.Commit(); err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return result, nil
 }
 
-func (db *DB) DeleteSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) error {
+// PruneSecretVersions keeps only the latest revisions of every secret of the user, keep <= 0 keeps all of them
+func (db *DB) PruneSecretVersions(ctx context.Context, auth *pb.Auth, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	_, err := db.conn.ExecContext(ctx, `
+		DELETE FROM secret_versions v
+		WHERE v.username=$1 AND v.version <= (
+			SELECT MAX(version) FROM secret_versions
+			WHERE secret_id=v.secret_id AND username=v.username
+		) - $2;
+	`, auth.GetUsername(), keep)
+
+	return err
+}
+
+// DeleteSecrets moves secrets to the trash, the content is kept until the secrets are purged.
+// The secret is deleted only if its revision matches the current one, otherwise it's reported as a conflict
+func (db *DB) DeleteSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error) {
 	tx, err := db.conn.BeginTx(ctx, nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer rollbackTx(tx)
 
+	if err := lockAccount(ctx, tx, auth); err != nil {
+		return nil, err
+	}
+
 	stmtDeleteSecret, err := tx.Prepare(
 		`UPDATE secrets SET
-			labels=NULL,
-			created_at=NULL, 
-			updated_at=NULL, 
-			deleted_at=$3, 
-			data=NULL, 
-			deleted=true 
-			WHERE id=$1 AND username=$2;`,
+			deleted_at=$4, 
+			deleted=true,
+			revision=revision+1,
+			change_seq=nextval('secrets_change_seq') 
+			WHERE id=$1 AND username=$2 AND revision=$3
+			RETURNING revision;`,
 	)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer closeObject(stmtDeleteSecret)
 
+	result := NewSyncResult()
 	for _, secret := range secrets {
-		if _, err := stmtDeleteSecret.Exec(
-			secret.GetID(), auth.GetUsername(), secret.GetDeletedAt().AsTime(),
-		); err != nil {
-			return err
+		var revision uint64
+		err := stmtDeleteSecret.QueryRow(
+			secret.GetID(), auth.GetUsername(), secret.GetRevision(), secret.GetDeletedAt().AsTime(),
+		).Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			if err := result.AddRejectedRow(getConflict(ctx, tx, auth, secret.GetID()), secret, HasDelete); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
 		}
+
+		result.Revisions[secret.GetID()] = revision
 	}
 
 	if err := tx.Commit(); err != nil {
-		return err
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PurgeSecrets permanently removes secrets of all users deleted before the provided time
+func (db *DB) PurgeSecrets(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := db.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
 	}
+	defer rollbackTx(tx)
 
-	return nil
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM secret_versions v USING secrets s
+		WHERE v.secret_id=s.id AND v.username=s.username
+		AND s.deleted=true AND s.deleted_at < $1;
+	`, before); err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM secrets WHERE deleted=true AND deleted_at < $1;
+	`, before)
+	if err != nil {
+		return 0, err
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, tx.Commit()
 }
 
-func (db *DB) ListSecrets(ctx context.Context, auth *pb.Auth) ([]*pb.Secret, error) {
+// ListSecrets lists secrets of the user changed after the since change sequence,
+// the returned cursor is the change sequence of the last listed secret
+func (db *DB) ListSecrets(ctx context.Context, auth *pb.Auth, since uint64) ([]*pb.Secret, uint64, error) {
 	var secrets []*pb.Secret
 	rows, err := db.conn.QueryContext(ctx, `
 		SELECT id, labels, 
 		created_at, updated_at, deleted_at, deleted,
-		data 
+		data, payload, revision, change_seq 
 		FROM secrets
-		WHERE username=$1;
-	`, auth.GetUsername())
+		WHERE username=$1 AND change_seq > $2
+		ORDER BY change_seq;
+	`, auth.GetUsername(), since)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 	defer closeObject(rows)
 
+	cursor := since
 	for rows.Next() {
-		var createdAtString, updatedAtString, deletedAtString sql.NullString
-		secret := &pb.Secret{
-			ID:     "",
-			Labels: make(map[string]string, 0),
-			Data:   make([]byte, 0),
-			Status: &pb.Status{},
+		secret, err := ScanSecret(rows, &cursor)
+		if err != nil {
+			return nil, 0, err
 		}
 
-		labels := make([]byte, 0)
+		secrets = append(secrets, secret)
+	}
 
-		err = rows.Scan(
-			&secret.ID, &labels,
-			&createdAtString, &updatedAtString, &deletedAtString, &secret.Status.Deleted,
-			&secret.Data)
-		if err != nil {
-			return nil, err
-		}
+	return secrets, cursor, rows.Err()
+}
 
-		if len(labels) > 0 {
-			if err := json.Unmarshal(labels, &secret.Labels); err != nil {
-				return nil, err
-			}
-		}
+// lockAccount serializes changes of the user secrets, so the change sequence is committed in order
+// and a reader never skips a change committed after it has seen a greater sequence
+func lockAccount(ctx context.Context, tx *sql.Tx, auth *pb.Auth) error {
+	_, err := tx.ExecContext(ctx, `SELECT 1 FROM accounts WHERE username=$1 FOR UPDATE;`, auth.GetUsername())
 
-		if createdAtString.String != "" {
-			createdAt, err := time.Parse(time.RFC3339, createdAtString.String)
-			if err != nil {
-				return nil, err
-			}
-			secret.CreatedAt = timestamppb.New(createdAt)
+	return err
+}
+
+// AddRejectedRow reports the secret the change wasn't applied to with its current state scanned from the row,
+// the secret missing on the server (e.g. purged) is reported as not found
+func (r *SyncResult) AddRejectedRow(row *sql.Row, secret *pb.Secret, applied func(stored, secret *pb.Secret) (bool, error)) error {
+	stored, err := ScanSecret(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		r.NotFound = append(r.NotFound, secret.GetID())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	return r.addRejected(stored, secret, applied)
+}
+
+// getConflict selects the current state of the secret rejected as stale
+func getConflict(ctx context.Context, tx *sql.Tx, auth *pb.Auth, id string) *sql.Row {
+	return tx.QueryRowContext(ctx, `
+		SELECT id, labels, 
+		created_at, updated_at, deleted_at, deleted,
+		data, payload, revision 
+		FROM secrets
+		WHERE id=$1 AND username=$2;
+	`, id, auth.GetUsername())
+}
+
+// ScanSecret scans the secret columns of SQL backends (id, labels, created_at, updated_at, deleted_at, deleted,
+// data, payload, revision) followed by the extra ones
+func ScanSecret(row interface {
+	Scan(dest ...interface{}) error
+}, extra ...interface{}) (*pb.Secret, error) {
+	var createdAtString, updatedAtString, deletedAtString sql.NullString
+	secret := &pb.Secret{
+		ID:     "",
+		Labels: make(map[string]string, 0),
+		Data:   make([]byte, 0),
+		Status: &pb.Status{},
+	}
+
+	labels := make([]byte, 0)
+	var secretPayload []byte
+
+	dest := []interface{}{
+		&secret.ID, &labels,
+		&createdAtString, &updatedAtString, &deletedAtString, &secret.Status.Deleted,
+		&secret.Data, &secretPayload, &secret.Revision,
+	}
+
+	err := row.Scan(append(dest, extra...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payload.Unmarshal(secretPayload, secret); err != nil {
+		return nil, err
+	}
+
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &secret.Labels); err != nil {
+			return nil, err
 		}
+	}
 
-		if updatedAtString.String != "" {
-			updatedAt, err := time.Parse(time.RFC3339, updatedAtString.String)
-			if err != nil {
-				return nil, err
-			}
-			secret.UpdatedAt = timestamppb.New(updatedAt)
+	if createdAtString.String != "" {
+		createdAt, err := time.Parse(time.RFC3339, createdAtString.String)
+		if err != nil {
+			return nil, err
 		}
+		secret.CreatedAt = timestamppb.New(createdAt)
+	}
 
-		if deletedAtString.String != "" {
-			deletedAt, err := time.Parse(time.RFC3339, deletedAtString.String)
-			if err != nil {
-				return nil, err
-			}
-			secret.DeletedAt = timestamppb.New(deletedAt)
+	if updatedAtString.String != "" {
+		updatedAt, err := time.Parse(time.RFC3339, updatedAtString.String)
+		if err != nil {
+			return nil, err
 		}
+		secret.UpdatedAt = timestamppb.New(updatedAt)
+	}
 
-		secrets = append(secrets, secret)
+	if deletedAtString.String != "" {
+		deletedAt, err := time.Parse(time.RFC3339, deletedAtString.String)
+		if err != nil {
+			return nil, err
+		}
+		secret.DeletedAt = timestamppb.New(deletedAt)
 	}
 
-	return secrets, nil
+	return secret, nil
 }
 
 func (db *DB) Close() error {
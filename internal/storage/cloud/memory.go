@@ -0,0 +1,330 @@
+package cloud
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const memoryScheme = "memory"
+
+var (
+	_ Storage = (*memoryStorage)(nil)
+)
+
+// memorySecret is the stored secret with the owner and the change sequence of the last change
+type memorySecret struct {
+	username  string
+	secret    *pb.Secret
+	changeSeq uint64
+	versions  []uint64
+}
+
+// memoryStorage keeps the store in memory only, everything is lost on server restart.
+// Every method holds the lock for the whole batch, so batches are applied atomically
+type memoryStorage struct {
+	mu        sync.Mutex
+	accounts  map[string][]byte
+	vaultKeys map[string]*pb.VaultKey
+	secrets   map[string]*memorySecret
+	changeSeq uint64
+}
+
+func init() {
+	Register(memoryScheme, func(string) (Storage, error) {
+		return NewMemoryStorage(), nil
+	})
+}
+
+func NewMemoryStorage() *memoryStorage {
+	return &memoryStorage{
+		accounts:  make(map[string][]byte),
+		vaultKeys: make(map[string]*pb.VaultKey),
+		secrets:   make(map[string]*memorySecret),
+	}
+}
+
+func (ms *memoryStorage) CreateAccount(_ context.Context, auth *pb.Auth, vaultKey *pb.VaultKey) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.accounts[auth.GetUsername()]; ok {
+		return ErrAccountExists
+	}
+
+	ms.accounts[auth.GetUsername()] = append([]byte(nil), auth.GetPassword()...)
+	if vaultKey != nil {
+		ms.vaultKeys[auth.GetUsername()] = proto.Clone(vaultKey).(*pb.VaultKey)
+	}
+
+	return nil
+}
+
+func (ms *memoryStorage) GetByName(_ context.Context, username string) (*pb.Auth, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	password, ok := ms.accounts[username]
+	if !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	return &pb.Auth{
+		Username: username,
+		Password: append([]byte(nil), password...),
+	}, nil
+}
+
+func (ms *memoryStorage) GetVaultKey(_ context.Context, username string) (*pb.VaultKey, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.accounts[username]; !ok {
+		return nil, ErrAccountNotFound
+	}
+
+	vaultKey, ok := ms.vaultKeys[username]
+	if !ok {
+		return nil, nil
+	}
+
+	return proto.Clone(vaultKey).(*pb.VaultKey), nil
+}
+
+func (ms *memoryStorage) UpdateVaultKey(_ context.Context, username string, vaultKey *pb.VaultKey) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if _, ok := ms.accounts[username]; !ok {
+		return ErrAccountNotFound
+	}
+
+	if vaultKey == nil {
+		delete(ms.vaultKeys, username)
+		return nil
+	}
+
+	ms.vaultKeys[username] = proto.Clone(vaultKey).(*pb.VaultKey)
+
+	return nil
+}
+
+// CreateSecrets creates secrets with the first revision, the secret of the user which already exists
+// is acknowledged if it has the labels and content of the created one, otherwise it's reported as a conflict
+func (ms *memoryStorage) CreateSecrets(_ context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	for _, secret := range secrets {
+		if stored, ok := ms.secrets[secret.GetID()]; ok && stored.username != auth.GetUsername() {
+			return nil, ErrSecretExists
+		}
+	}
+
+	result := NewSyncResult()
+	for _, secret := range secrets {
+		if _, ok := ms.secrets[secret.GetID()]; ok {
+			if err := ms.addRejected(result, auth, secret, HasUpdate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		created := &pb.Secret{
+			ID:        secret.GetID(),
+			Labels:    secret.GetLabels(),
+			CreatedAt: timestamppb.New(secret.GetCreatedAt().AsTime()),
+			Data:      secret.GetData(),
+			Payload:   secret.GetPayload(),
+			Revision:  1,
+		}
+
+		ms.secrets[secret.GetID()] = &memorySecret{
+			username:  auth.GetUsername(),
+			secret:    proto.Clone(created).(*pb.Secret),
+			changeSeq: ms.nextChangeSeq(),
+		}
+
+		result.Revisions[secret.GetID()] = 1
+	}
+
+	return result, nil
+}
+
+// UpdateSecrets updates secrets content, updated secrets are moved out of the trash.
+// The secret is updated only if its revision matches the current one, otherwise it's reported as a conflict
+func (ms *memoryStorage) UpdateSecrets(_ context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	result := NewSyncResult()
+	for _, secret := range secrets {
+		stored, ok := ms.secrets[secret.GetID()]
+		if !ok || stored.username != auth.GetUsername() || stored.secret.GetRevision() != secret.GetRevision() {
+			if err := ms.addRejected(result, auth, secret, HasUpdate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		changed, err := contentChanged(stored.secret, secret)
+		if err != nil {
+			return nil, err
+		}
+
+		// The current revision is archived if data or payload changes
+		if changed && !stored.secret.GetStatus().GetDeleted() {
+			var version uint64 = 1
+			if len(stored.versions) > 0 {
+				version = stored.versions[len(stored.versions)-1] + 1
+			}
+			stored.versions = append(stored.versions, version)
+		}
+
+		updated := &pb.Secret{
+			ID:        secret.GetID(),
+			Labels:    secret.GetLabels(),
+			CreatedAt: stored.secret.GetCreatedAt(),
+			UpdatedAt: timestamppb.New(secret.GetUpdatedAt().AsTime()),
+			Data:      secret.GetData(),
+			Payload:   secret.GetPayload(),
+			Revision:  stored.secret.GetRevision() + 1,
+		}
+		stored.secret = proto.Clone(updated).(*pb.Secret)
+		stored.changeSeq = ms.nextChangeSeq()
+
+		result.Revisions[secret.GetID()] = stored.secret.GetRevision()
+	}
+
+	return result, nil
+}
+
+// PruneSecretVersions keeps only the latest revisions of every secret of the user, keep <= 0 keeps all of them
+func (ms *memoryStorage) PruneSecretVersions(_ context.Context, auth *pb.Auth, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	for _, stored := range ms.secrets {
+		if stored.username == auth.GetUsername() && len(stored.versions) > keep {
+			stored.versions = append([]uint64(nil), stored.versions[len(stored.versions)-keep:]...)
+		}
+	}
+
+	return nil
+}
+
+// DeleteSecrets moves secrets to the trash, the content is kept until the secrets are purged.
+// The secret is deleted only if its revision matches the current one, otherwise it's reported as a conflict
+func (ms *memoryStorage) DeleteSecrets(_ context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	result := NewSyncResult()
+	for _, secret := range secrets {
+		stored, ok := ms.secrets[secret.GetID()]
+		if !ok || stored.username != auth.GetUsername() || stored.secret.GetRevision() != secret.GetRevision() {
+			if err := ms.addRejected(result, auth, secret, HasDelete); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		stored.secret.DeletedAt = timestamppb.New(secret.GetDeletedAt().AsTime())
+		stored.secret.Status = &pb.Status{Deleted: true}
+		stored.secret.Revision++
+		stored.changeSeq = ms.nextChangeSeq()
+
+		result.Revisions[secret.GetID()] = stored.secret.GetRevision()
+	}
+
+	return result, nil
+}
+
+// PurgeSecrets permanently removes secrets of all users deleted before the provided time
+func (ms *memoryStorage) PurgeSecrets(_ context.Context, before time.Time) (int64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var purged int64
+	for id, stored := range ms.secrets {
+		if stored.secret.GetStatus().GetDeleted() && stored.secret.GetDeletedAt().AsTime().Before(before) {
+			delete(ms.secrets, id)
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// ListSecrets lists secrets of the user changed after the since change sequence,
+// the returned cursor is the change sequence of the last listed secret
+func (ms *memoryStorage) ListSecrets(_ context.Context, auth *pb.Auth, since uint64) ([]*pb.Secret, uint64, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	var changed []*memorySecret
+	for _, stored := range ms.secrets {
+		if stored.username == auth.GetUsername() && stored.changeSeq > since {
+			changed = append(changed, stored)
+		}
+	}
+
+	sort.Slice(changed, func(i, j int) bool {
+		return changed[i].changeSeq < changed[j].changeSeq
+	})
+
+	cursor := since
+	secrets := make([]*pb.Secret, 0, len(changed))
+	for _, stored := range changed {
+		secrets = append(secrets, cloneSecret(stored.secret))
+		cursor = stored.changeSeq
+	}
+
+	return secrets, cursor, nil
+}
+
+func (ms *memoryStorage) Close() error {
+	return nil
+}
+
+func (ms *memoryStorage) nextChangeSeq() uint64 {
+	ms.changeSeq++
+
+	return ms.changeSeq
+}
+
+// addRejected reports the secret the change wasn't applied to with its current state,
+// the secret missing on the server (e.g. purged) is reported as not found
+func (ms *memoryStorage) addRejected(result *SyncResult, auth *pb.Auth, secret *pb.Secret,
+	applied func(stored, secret *pb.Secret) (bool, error)) error {
+	stored, ok := ms.secrets[secret.GetID()]
+	if !ok || stored.username != auth.GetUsername() {
+		result.NotFound = append(result.NotFound, secret.GetID())
+		return nil
+	}
+
+	return result.addRejected(cloneSecret(stored.secret), secret, applied)
+}
+
+// cloneSecret copies the stored secret the way it's scanned from the database
+func cloneSecret(secret *pb.Secret) *pb.Secret {
+	clone := proto.Clone(secret).(*pb.Secret)
+	if clone.Status == nil {
+		clone.Status = &pb.Status{}
+	}
+	if clone.Labels == nil {
+		clone.Labels = make(map[string]string, 0)
+	}
+
+	return clone
+}
@@ -0,0 +1,173 @@
+package cloud
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database"
+	"github.com/golang-migrate/migrate/v4/source"
+	"github.com/golang-migrate/migrate/v4/source/iofs"
+)
+
+// MigrationOpener opens schema migrations of the backend with the DSN without applying them
+type MigrationOpener func(dsn string) (*Migration, error)
+
+// MigrationStatus is the schema version of the store and the latest embedded one
+type MigrationStatus struct {
+	Version uint
+	Latest  uint
+	Dirty   bool
+}
+
+// Migration applies the embedded schema migrations of the store. The migration holds its own
+// database connection and the store lock (e.g. PostgreSQL advisory lock) while migrations are applied,
+// so several servers can start with the same store concurrently
+type Migration struct {
+	migrate *migrate.Migrate
+	latest  uint
+}
+
+var (
+	migrationsMu     sync.RWMutex
+	migrationOpeners = make(map[string]MigrationOpener)
+)
+
+// RegisterMigration makes schema migrations of the backend available by the DSN scheme,
+// it panics if the scheme is already taken
+func RegisterMigration(scheme string, opener MigrationOpener) {
+	migrationsMu.Lock()
+	defer migrationsMu.Unlock()
+
+	if opener == nil {
+		panic("cloud: RegisterMigration opener is nil")
+	}
+	if _, ok := migrationOpeners[scheme]; ok {
+		panic("cloud: RegisterMigration called twice for scheme " + scheme)
+	}
+
+	migrationOpeners[scheme] = opener
+}
+
+// OpenMigration opens schema migrations of the store selected by the DSN scheme
+func OpenMigration(dsn string) (*Migration, error) {
+	scheme := dsnScheme(dsn)
+
+	migrationsMu.RLock()
+	opener, ok := migrationOpeners[scheme]
+	migrationsMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("storage DSN scheme %q has no schema migrations", scheme)
+	}
+
+	return opener(dsn)
+}
+
+// NewMigration reads migrations embedded in the dir of fsys, the migration owns the database driver
+func NewMigration(fsys fs.FS, dir string, databaseName string, driver database.Driver) (*Migration, error) {
+	sourceDriver, err := iofs.New(fsys, dir)
+	if err != nil {
+		closeObject(driver)
+		return nil, err
+	}
+
+	latest, err := latestVersion(sourceDriver)
+	if err != nil {
+		closeObject(sourceDriver)
+		closeObject(driver)
+		return nil, err
+	}
+
+	m, err := migrate.NewWithInstance("iofs", sourceDriver, databaseName, driver)
+	if err != nil {
+		closeObject(sourceDriver)
+		closeObject(driver)
+		return nil, err
+	}
+
+	return &Migration{
+		migrate: m,
+		latest:  latest,
+	}, nil
+}
+
+// Up applies all pending migrations
+func (m *Migration) Up() error {
+	if err := m.migrate.Up(); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	return nil
+}
+
+// Down rolls back the steps latest applied migrations
+func (m *Migration) Down(steps int) error {
+	if steps < 1 {
+		return fmt.Errorf("invalid number of migrations to roll back: %d", steps)
+	}
+
+	if err := m.migrate.Steps(-steps); err != nil && !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	return nil
+}
+
+// Status returns the schema version of the store, the version is 0 if no migrations are applied
+func (m *Migration) Status() (*MigrationStatus, error) {
+	version, dirty, err := m.migrate.Version()
+	if err != nil && !errors.Is(err, migrate.ErrNilVersion) {
+		return nil, err
+	}
+
+	return &MigrationStatus{
+		Version: version,
+		Latest:  m.latest,
+		Dirty:   dirty,
+	}, nil
+}
+
+func (m *Migration) Close() error {
+	sourceErr, dbErr := m.migrate.Close()
+	if sourceErr != nil {
+		return sourceErr
+	}
+
+	return dbErr
+}
+
+// latestVersion returns the version of the last migration of the source
+func latestVersion(sourceDriver source.Driver) (uint, error) {
+	version, err := sourceDriver.First()
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	for {
+		next, err := sourceDriver.Next(version)
+		if errors.Is(err, os.ErrNotExist) {
+			return version, nil
+		}
+		if err != nil {
+			return 0, err
+		}
+		version = next
+	}
+}
+
+// dsnScheme returns the scheme of the DSN, DSN without a scheme is taken as PostgreSQL keyword/value connection string
+func dsnScheme(dsn string) string {
+	if i := strings.Index(dsn, "://"); i >= 0 {
+		return dsn[:i]
+	}
+
+	return postgresScheme
+}
@@ -0,0 +1,64 @@
+package cloud_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
+	_ "github.com/go-rfe/gpwd/internal/storage/cloud/sqlite"
+)
+
+func TestMigration(t *testing.T) {
+	dsn := "sqlite://" + filepath.Join(t.TempDir(), "server.db")
+
+	migration, err := cloud.OpenMigration(dsn)
+	if err != nil {
+		t.Fatalf("OpenMigration() error = %v", err)
+	}
+	defer migration.Close()
+
+	status, err := migration.Status()
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if status.Version != 0 || status.Latest == 0 || status.Dirty {
+		t.Fatalf("Status() before Up() = %+v, want version 0 and the latest version set", status)
+	}
+
+	if err := migration.Up(); err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if err := migration.Up(); err != nil {
+		t.Fatalf("Up() without pending migrations error = %v", err)
+	}
+
+	status, err = migration.Status()
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if status.Version != status.Latest {
+		t.Fatalf("Status() after Up() = %+v, want the latest version", status)
+	}
+
+	if err := migration.Down(1); err != nil {
+		t.Fatalf("Down() error = %v", err)
+	}
+
+	status, err = migration.Status()
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	if status.Version != status.Latest-1 {
+		t.Fatalf("Status() after Down(1) = %+v, want version %d", status, status.Latest-1)
+	}
+
+	if err := migration.Down(0); err == nil {
+		t.Fatal("Down(0) error = nil, want error")
+	}
+}
+
+func TestMigrationUnsupportedScheme(t *testing.T) {
+	if _, err := cloud.OpenMigration("memory://"); err == nil {
+		t.Fatal("OpenMigration(memory://) error = nil, want error")
+	}
+}
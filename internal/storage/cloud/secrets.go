@@ -1,14 +1,111 @@
 package cloud
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"time"
 
+	"github.com/go-rfe/gpwd/internal/payload"
 	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
+var ErrSecretExists = errors.New("secret already exists")
+
+// SyncResult reports new revisions of applied secrets by ID, the current state of secrets rejected as stale
+// and IDs of updated or deleted secrets which don't exist on the server
+type SyncResult struct {
+	Revisions map[string]uint64
+	Conflicts []*pb.Secret
+	NotFound  []string
+}
+
+// NewSyncResult returns the empty result for backends to fill in
+func NewSyncResult() *SyncResult {
+	return &SyncResult{
+		Revisions: make(map[string]uint64),
+	}
+}
+
 type Secrets interface {
-	CreateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) error
-	DeleteSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) error
-	UpdateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) error
-	ListSecrets(ctx context.Context, auth *pb.Auth) ([]*pb.Secret, error)
+	// CreateSecrets creates secrets with the first revision. The secret of the user which already exists is
+	// acknowledged with its current revision if the labels and content match, e.g. the create is retried
+	// after its acknowledgement was lost, otherwise it's reported as a conflict.
+	// ErrSecretExists is returned if the ID is taken by another user
+	CreateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error)
+	// DeleteSecrets and UpdateSecrets apply changes made on top of the current revision. The stale change
+	// is acknowledged with the current revision if the secret already has it, otherwise it's reported as a conflict.
+	// Secrets missing on the server, e.g. purged, are reported as not found
+	DeleteSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error)
+	UpdateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*SyncResult, error)
+	// ListSecrets lists secrets changed after the since change sequence, the returned cursor is the sequence
+	// of the last listed change to use as since next time
+	ListSecrets(ctx context.Context, auth *pb.Auth, since uint64) ([]*pb.Secret, uint64, error)
+	// PruneSecretVersions keeps only the latest revisions archived by UpdateSecrets, keep <= 0 keeps all of them
+	PruneSecretVersions(ctx context.Context, auth *pb.Auth, keep int) error
+	// PurgeSecrets permanently removes secrets of all users deleted before the provided time
+	PurgeSecrets(ctx context.Context, before time.Time) (int64, error)
+}
+
+// addRejected reports the secret the change wasn't applied to: the change the stored secret already has
+// is acknowledged with the current revision, other ones are reported as conflicts
+func (r *SyncResult) addRejected(stored, secret *pb.Secret, applied func(stored, secret *pb.Secret) (bool, error)) error {
+	ok, err := applied(stored, secret)
+	if err != nil {
+		return err
+	}
+
+	if ok {
+		r.Revisions[stored.GetID()] = stored.GetRevision()
+		return nil
+	}
+
+	r.Conflicts = append(r.Conflicts, stored)
+
+	return nil
+}
+
+// HasUpdate reports whether the stored secret is actual and has labels and content of the pushed one
+func HasUpdate(stored, secret *pb.Secret) (bool, error) {
+	if stored.GetStatus().GetDeleted() || !labelsEqual(stored.GetLabels(), secret.GetLabels()) {
+		return false, nil
+	}
+
+	changed, err := contentChanged(stored, secret)
+
+	return !changed, err
+}
+
+// HasDelete reports whether the stored secret is deleted
+func HasDelete(stored, _ *pb.Secret) (bool, error) {
+	return stored.GetStatus().GetDeleted(), nil
+}
+
+// contentChanged reports whether data or payload of the secret differs from the stored one
+func contentChanged(stored, secret *pb.Secret) (bool, error) {
+	storedPayload, err := payload.Marshal(stored)
+	if err != nil {
+		return false, err
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(stored.GetData(), secret.GetData()) || !bytes.Equal(storedPayload, secretPayload), nil
+}
+
+func labelsEqual(a, b map[string]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for key, value := range a {
+		if other, ok := b[key]; !ok || other != value {
+			return false
+		}
+	}
+
+	return true
 }
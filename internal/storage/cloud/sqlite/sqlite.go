@@ -0,0 +1,474 @@
+// Package sqlite implements the server store kept in the SQLite database, the backend is registered on import
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	migratesqlite "github.com/golang-migrate/migrate/v4/database/sqlite3"
+	"github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/db/migrations"
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
+)
+
+const (
+	sqliteScheme     = "sqlite"
+	sqliteDriverName = "sqlite3"
+
+	// sqliteTimeFormat is RFC 3339 with fixed precision, so stored UTC times are ordered as strings
+	sqliteTimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+)
+
+var (
+	_ cloud.Storage = (*sqliteStorage)(nil)
+)
+
+// sqliteStorage is the embedded store for small deployments, the DSN is sqlite://<path>
+type sqliteStorage struct {
+	conn *sql.DB
+}
+
+func init() {
+	cloud.Register(sqliteScheme, func(dsn string) (cloud.Storage, error) {
+		return NewStorage(strings.TrimPrefix(dsn, sqliteScheme+"://"))
+	})
+	cloud.RegisterMigration(sqliteScheme, func(dsn string) (*cloud.Migration, error) {
+		return NewMigration(strings.TrimPrefix(dsn, sqliteScheme+"://"))
+	})
+}
+
+// NewStorage opens the SQLite store and applies pending schema migrations
+func NewStorage(databasePath string) (*sqliteStorage, error) {
+	migration, err := NewMigration(databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	err = migration.Up()
+	closeObject(migration)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := sql.Open(sqliteDriverName, databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	// SQLite has a single writer, a single connection serializes changes instead of locking accounts
+	conn.SetMaxOpenConns(1)
+
+	return &sqliteStorage{
+		conn: conn,
+	}, nil
+}
+
+// NewMigration opens schema migrations of the SQLite store
+func NewMigration(databasePath string) (*cloud.Migration, error) {
+	conn, err := sql.Open(sqliteDriverName, databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, err := migratesqlite.WithInstance(conn, &migratesqlite.Config{})
+	if err != nil {
+		closeObject(conn)
+		return nil, err
+	}
+
+	return cloud.NewMigration(migrations.ServerSQLite, "server-sqlite", sqliteDriverName, driver)
+}
+
+func (ss *sqliteStorage) CreateAccount(ctx context.Context, auth *pb.Auth, vaultKey *pb.VaultKey) error {
+	var sqliteErr sqlite3.Error
+
+	key, err := cloud.MarshalVaultKey(vaultKey)
+	if err != nil {
+		return err
+	}
+
+	_, err = ss.conn.ExecContext(ctx, `
+		INSERT INTO accounts (username, password, vault_key) VALUES (?1, ?2, ?3);`,
+		auth.GetUsername(), auth.GetPassword(), key,
+	)
+
+	if err != nil && errors.As(err, &sqliteErr) && sqliteErr.ExtendedCode == sqlite3.ErrConstraintUnique {
+		return cloud.ErrAccountExists
+	}
+
+	return err
+}
+
+func (ss *sqliteStorage) GetByName(ctx context.Context, username string) (*pb.Auth, error) {
+	var userPassword []byte
+	row := ss.conn.QueryRowContext(ctx,
+		"SELECT password FROM accounts WHERE username = ?1", username)
+
+	err := row.Scan(&userPassword)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, cloud.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.Auth{
+		Username: username,
+		Password: userPassword,
+	}, nil
+}
+
+func (ss *sqliteStorage) GetVaultKey(ctx context.Context, username string) (*pb.VaultKey, error) {
+	var key []byte
+	err := ss.conn.QueryRowContext(ctx,
+		"SELECT vault_key FROM accounts WHERE username = ?1", username).Scan(&key)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, cloud.ErrAccountNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return cloud.UnmarshalVaultKey(key)
+}
+
+func (ss *sqliteStorage) UpdateVaultKey(ctx context.Context, username string, vaultKey *pb.VaultKey) error {
+	key, err := cloud.MarshalVaultKey(vaultKey)
+	if err != nil {
+		return err
+	}
+
+	result, err := ss.conn.ExecContext(ctx,
+		"UPDATE accounts SET vault_key = ?1 WHERE username = ?2", key, username)
+	if err != nil {
+		return err
+	}
+
+	return cloud.AccountUpdated(result)
+}
+
+// CreateSecrets creates secrets with the first revision, the secret of the user which already exists
+// is acknowledged if it has the labels and content of the created one, otherwise it's reported as a conflict
+func (ss *sqliteStorage) CreateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*cloud.SyncResult, error) {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackTx(tx)
+
+	result := cloud.NewSyncResult()
+	for _, secret := range secrets {
+		var owner string
+		err := tx.QueryRowContext(ctx, `SELECT username FROM secrets WHERE id=?1;`, secret.GetID()).Scan(&owner)
+		switch {
+		case errors.Is(err, sql.ErrNoRows):
+		case err != nil:
+			return nil, err
+		case owner != auth.GetUsername():
+			return nil, cloud.ErrSecretExists
+		default:
+			if err := result.AddRejectedRow(ss.getConflict(ctx, tx, auth, secret.GetID()), secret, cloud.HasUpdate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		metadata, secretPayload, err := marshalSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		changeSeq, err := nextChangeSeq(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO secrets
+			(id, username, labels, created_at, data, payload, revision, change_seq)
+			VALUES (?1, ?2, ?3, ?4, ?5, ?6, 1, ?7);
+		`, secret.GetID(), auth.GetUsername(),
+			metadata, formatTime(secret.GetCreatedAt()),
+			secret.GetData(), secretPayload, changeSeq,
+		); err != nil {
+			return nil, err
+		}
+
+		result.Revisions[secret.GetID()] = 1
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// UpdateSecrets updates secrets content, updated secrets are moved out of the trash.
+// The secret is updated only if its revision matches the current one, otherwise it's reported as a conflict
+func (ss *sqliteStorage) UpdateSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*cloud.SyncResult, error) {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackTx(tx)
+
+	result := cloud.NewSyncResult()
+	for _, secret := range secrets {
+		metadata, secretPayload, err := marshalSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+
+		// The current revision is archived if data or payload changes
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO secret_versions
+			(secret_id, username, version, labels, created_at, updated_at, data, payload, archived_at)
+			SELECT id, username,
+			COALESCE((SELECT MAX(version) FROM secret_versions WHERE secret_id=?1 AND username=?2), 0) + 1,
+			labels, created_at, updated_at, data, payload, ?6
+			FROM secrets
+			WHERE id=?1 AND username=?2 AND revision=?3 AND deleted=false
+			AND (data IS NOT ?4 OR payload IS NOT ?5);
+		`, secret.GetID(), auth.GetUsername(), secret.GetRevision(),
+			secret.GetData(), secretPayload, formatTime(timestamppb.Now()),
+		); err != nil {
+			return nil, err
+		}
+
+		changeSeq, err := nextChangeSeq(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		var revision uint64
+		err = tx.QueryRowContext(ctx, `
+			UPDATE secrets SET
+			labels=?4, updated_at=?5, data=?6, payload=?7, deleted=false, deleted_at=NULL, revision=revision+1,
+			change_seq=?8
+			WHERE id=?1 AND username=?2 AND revision=?3
+			RETURNING revision;
+		`, secret.GetID(), auth.GetUsername(), secret.GetRevision(),
+			metadata, formatTime(secret.GetUpdatedAt()),
+			secret.GetData(), secretPayload, changeSeq,
+		).Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			if err := result.AddRejectedRow(ss.getConflict(ctx, tx, auth, secret.GetID()), secret, cloud.HasUpdate); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Revisions[secret.GetID()] = revision
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PruneSecretVersions keeps only the latest revisions of every secret of the user, keep <= 0 keeps all of them
+func (ss *sqliteStorage) PruneSecretVersions(ctx context.Context, auth *pb.Auth, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	_, err := ss.conn.ExecContext(ctx, `
+		DELETE FROM secret_versions
+		WHERE username=?1 AND version <= (
+			SELECT MAX(v.version) FROM secret_versions v
+			WHERE v.secret_id=secret_versions.secret_id AND v.username=secret_versions.username
+		) - ?2;
+	`, auth.GetUsername(), keep)
+
+	return err
+}
+
+// DeleteSecrets moves secrets to the trash, the content is kept until the secrets are purged.
+// The secret is deleted only if its revision matches the current one, otherwise it's reported as a conflict
+func (ss *sqliteStorage) DeleteSecrets(ctx context.Context, auth *pb.Auth, secrets []*pb.Secret) (*cloud.SyncResult, error) {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackTx(tx)
+
+	result := cloud.NewSyncResult()
+	for _, secret := range secrets {
+		changeSeq, err := nextChangeSeq(ctx, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		var revision uint64
+		err = tx.QueryRowContext(ctx, `
+			UPDATE secrets SET
+			deleted_at=?4,
+			deleted=true,
+			revision=revision+1,
+			change_seq=?5
+			WHERE id=?1 AND username=?2 AND revision=?3
+			RETURNING revision;
+		`, secret.GetID(), auth.GetUsername(), secret.GetRevision(),
+			formatTime(secret.GetDeletedAt()), changeSeq,
+		).Scan(&revision)
+		if errors.Is(err, sql.ErrNoRows) {
+			if err := result.AddRejectedRow(ss.getConflict(ctx, tx, auth, secret.GetID()), secret, cloud.HasDelete); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		result.Revisions[secret.GetID()] = revision
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// PurgeSecrets permanently removes secrets of all users deleted before the provided time
+func (ss *sqliteStorage) PurgeSecrets(ctx context.Context, before time.Time) (int64, error) {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer rollbackTx(tx)
+
+	purgeBefore := formatTime(timestamppb.New(before))
+
+	if _, err := tx.ExecContext(ctx, `
+		DELETE FROM secret_versions WHERE EXISTS (
+			SELECT 1 FROM secrets s
+			WHERE s.id=secret_versions.secret_id AND s.username=secret_versions.username
+			AND s.deleted=true AND s.deleted_at < ?1
+		);
+	`, purgeBefore); err != nil {
+		return 0, err
+	}
+
+	result, err := tx.ExecContext(ctx, `
+		DELETE FROM secrets WHERE deleted=true AND deleted_at < ?1;
+	`, purgeBefore)
+	if err != nil {
+		return 0, err
+	}
+
+	purged, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return purged, tx.Commit()
+}
+
+// ListSecrets lists secrets of the user changed after the since change sequence,
+// the returned cursor is the change sequence of the last listed secret
+func (ss *sqliteStorage) ListSecrets(ctx context.Context, auth *pb.Auth, since uint64) ([]*pb.Secret, uint64, error) {
+	var secrets []*pb.Secret
+	rows, err := ss.conn.QueryContext(ctx, `
+		SELECT id, labels,
+		created_at, updated_at, deleted_at, deleted,
+		data, payload, revision, change_seq
+		FROM secrets
+		WHERE username=?1 AND change_seq > ?2
+		ORDER BY change_seq;
+	`, auth.GetUsername(), since)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer closeObject(rows)
+
+	cursor := since
+	for rows.Next() {
+		secret, err := cloud.ScanSecret(rows, &cursor)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, cursor, rows.Err()
+}
+
+func (ss *sqliteStorage) Close() error {
+	return ss.conn.Close()
+}
+
+// getConflict selects the current state of the secret rejected as stale
+func (ss *sqliteStorage) getConflict(ctx context.Context, tx *sql.Tx, auth *pb.Auth, id string) *sql.Row {
+	return tx.QueryRowContext(ctx, `
+		SELECT id, labels,
+		created_at, updated_at, deleted_at, deleted,
+		data, payload, revision
+		FROM secrets
+		WHERE id=?1 AND username=?2;
+	`, id, auth.GetUsername())
+}
+
+// nextChangeSeq takes the next value of the change sequence
+func nextChangeSeq(ctx context.Context, tx *sql.Tx) (uint64, error) {
+	var changeSeq uint64
+	err := tx.QueryRowContext(ctx, `
+		UPDATE secrets_change_seq SET value=value+1 WHERE id=1 RETURNING value;
+	`).Scan(&changeSeq)
+
+	return changeSeq, err
+}
+
+// marshalSecret returns labels and payload of the secret as stored
+func marshalSecret(secret *pb.Secret) ([]byte, []byte, error) {
+	var metadata []byte
+	if secret.Labels != nil {
+		var err error
+		metadata, err = json.Marshal(secret.GetLabels())
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return metadata, secretPayload, nil
+}
+
+// formatTime formats the time in UTC, missing time is stored as the zero Unix time the same way as PostgreSQL does
+func formatTime(t *timestamppb.Timestamp) string {
+	return t.AsTime().UTC().Format(sqliteTimeFormat)
+}
+
+func closeObject(closer io.Closer) {
+	if err := closer.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close object")
+	}
+}
+
+func rollbackTx(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		log.Error().Err(err).Msg("Failed to rollback transaction")
+	}
+}
@@ -0,0 +1,68 @@
+package cloud
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Storage is the server store, every backend keeps accounts and secrets together
+type Storage interface {
+	Accounts
+	Secrets
+	io.Closer
+}
+
+// Opener opens the store of the backend with the DSN
+type Opener func(dsn string) (Storage, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = make(map[string]Opener)
+)
+
+// Register makes the storage backend available by the DSN scheme, it panics if the scheme is already taken
+func Register(scheme string, opener Opener) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+
+	if opener == nil {
+		panic("cloud: Register opener is nil")
+	}
+	if _, ok := backends[scheme]; ok {
+		panic("cloud: Register called twice for scheme " + scheme)
+	}
+
+	backends[scheme] = opener
+}
+
+// Schemes returns sorted DSN schemes of registered storage backends
+func Schemes() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+
+	schemes := make([]string, 0, len(backends))
+	for scheme := range backends {
+		schemes = append(schemes, scheme)
+	}
+	sort.Strings(schemes)
+
+	return schemes
+}
+
+// Open opens the store with the backend selected by the DSN scheme,
+// DSN without a scheme is taken as PostgreSQL keyword/value connection string
+func Open(dsn string) (Storage, error) {
+	scheme := dsnScheme(dsn)
+
+	backendsMu.RLock()
+	opener, ok := backends[scheme]
+	backendsMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown storage DSN scheme %q, available schemes: %v", scheme, Schemes())
+	}
+
+	return opener(dsn)
+}
@@ -0,0 +1,43 @@
+package cloud_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-rfe/gpwd/internal/storage/cloud"
+	"github.com/go-rfe/gpwd/internal/storage/cloud/cloudtest"
+	_ "github.com/go-rfe/gpwd/internal/storage/cloud/sqlite"
+)
+
+// Every backend must pass the conformance suite, PostgreSQL is tested if DATABASE_DSN is set
+func TestBackends(t *testing.T) {
+	backends := map[string]func(t *testing.T) string{
+		"memory": func(t *testing.T) string {
+			return "memory://"
+		},
+		"sqlite": func(t *testing.T) string {
+			return "sqlite://" + filepath.Join(t.TempDir(), "server.db")
+		},
+	}
+
+	if dsn := os.Getenv("DATABASE_DSN"); dsn != "" {
+		backends["postgres"] = func(t *testing.T) string {
+			return dsn
+		}
+	}
+
+	for name, dsn := range backends {
+		dsn := dsn
+		t.Run(name, func(t *testing.T) {
+			cloudtest.Run(t, func(t *testing.T) cloud.Storage {
+				storage, err := cloud.Open(dsn(t))
+				if err != nil {
+					t.Fatalf("Open() error = %v", err)
+				}
+
+				return storage
+			})
+		})
+	}
+}
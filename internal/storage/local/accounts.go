@@ -2,13 +2,23 @@ package local
 
 import (
 	"context"
+	"errors"
 
 	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
+var (
+	ErrAccountNotExists = errors.New("account doesn't exist")
+	ErrAccountExists    = errors.New("account already exists")
+)
+
 type Accounts interface {
 	CreateAccount(ctx context.Context, secret *pb.Account) (string, error)
 	GetAccount(ctx context.Context) (*pb.Account, error)
 	UpdateAccount(ctx context.Context, secret *pb.Account) error
 	DeleteAccount(ctx context.Context) error
+	// GetSyncCursor returns the server change sequence the account is synced up to,
+	// the cursor is reset if the server or the user changes
+	GetSyncCursor(ctx context.Context) (uint64, error)
+	UpdateSyncCursor(ctx context.Context, cursor uint64) error
 }
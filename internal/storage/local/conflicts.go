@@ -0,0 +1,20 @@
+package local
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var ErrNoSecretConflictFound = errors.New("no secret conflict found with provided id")
+
+// SecretConflicts keeps the remote side of secrets changed both locally and on the server,
+// the local side is the secret itself
+type SecretConflicts interface {
+	// SaveSecretConflict creates the conflict or replaces its remote side keeping the time of detection
+	SaveSecretConflict(ctx context.Context, remote *pb.Secret) error
+	ListSecretConflicts(ctx context.Context) ([]*pb.SecretConflict, error)
+	GetSecretConflict(ctx context.Context, id string) (*pb.SecretConflict, error)
+	DeleteSecretConflict(ctx context.Context, id string) error
+}
@@ -0,0 +1,896 @@
+// Package localtest implements the conformance suite every local storage backend must pass
+package localtest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/selector"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+// Opener returns a new empty store, the store is closed by the suite
+type Opener func(t *testing.T) local.Storage
+
+// Run runs the conformance suite against stores returned by open
+func Run(t *testing.T, open Opener) {
+	tests := []struct {
+		name string
+		test func(t *testing.T, ctx context.Context, storage local.Storage)
+	}{
+		{"CreateSecret", testCreateSecret},
+		{"CreateSecrets", testCreateSecrets},
+		{"RestoreSecrets", testRestoreSecrets},
+		{"ListSecrets", testListSecrets},
+		{"QuerySecrets", testQuerySecrets},
+		{"UpdateSecret", testUpdateSecret},
+		{"ReplaceSecret", testReplaceSecret},
+		{"MarkSecretSynced", testMarkSecretSynced},
+		{"DeleteSecret", testDeleteSecret},
+		{"PurgeSecret", testPurgeSecret},
+		{"SecretVersions", testSecretVersions},
+		{"SecretConflicts", testSecretConflicts},
+		{"Accounts", testAccounts},
+		{"Vault", testVault},
+		{"Rekey", testRekey},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			storage := open(t)
+			t.Cleanup(func() {
+				if err := storage.Close(); err != nil {
+					t.Errorf("Close() error = %v", err)
+				}
+			})
+
+			tt.test(t, context.Background(), storage)
+		})
+	}
+}
+
+func newSecret(id string) *pb.Secret {
+	return &pb.Secret{
+		ID:        id,
+		Labels:    map[string]string{"site": "example.com"},
+		CreatedAt: timestamppb.New(time.Unix(1600000000, 123456789)),
+		Data:      []byte("data " + id),
+		Status:    &pb.Status{},
+	}
+}
+
+func newLogin(id string) *pb.Secret {
+	secret := newSecret(id)
+	secret.Data = nil
+	secret.Payload = &pb.Secret_Login{Login: &pb.LoginPayload{
+		Username: "user",
+		Password: []byte("password"),
+		URIs:     []string{"https://example.com"},
+	}}
+
+	return secret
+}
+
+func create(t *testing.T, ctx context.Context, storage local.Storage, secrets ...*pb.Secret) {
+	t.Helper()
+
+	for _, secret := range secrets {
+		if _, err := storage.CreateSecret(ctx, secret); err != nil {
+			t.Fatalf("CreateSecret(%s) error = %v", secret.GetID(), err)
+		}
+	}
+}
+
+func get(t *testing.T, ctx context.Context, storage local.Storage, id string) *pb.Secret {
+	t.Helper()
+
+	secret, err := storage.GetSecret(ctx, id)
+	if err != nil {
+		t.Fatalf("GetSecret(%s) error = %v", id, err)
+	}
+
+	return secret
+}
+
+func update(t *testing.T, ctx context.Context, storage local.Storage, secret *pb.Secret) {
+	t.Helper()
+
+	if err := storage.UpdateSecret(ctx, secret); err != nil {
+		t.Fatalf("UpdateSecret(%s) error = %v", secret.GetID(), err)
+	}
+}
+
+func assertEqual(t *testing.T, what string, want, got proto.Message) {
+	t.Helper()
+
+	if !proto.Equal(want, got) {
+		t.Errorf("%s = %v, want %v", what, got, want)
+	}
+}
+
+func ids(secrets []*pb.Secret) []string {
+	result := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		result = append(result, secret.GetID())
+	}
+
+	return result
+}
+
+func assertIDs(t *testing.T, what string, want []string, secrets []*pb.Secret) {
+	t.Helper()
+
+	got := ids(secrets)
+	if len(got) != len(want) {
+		t.Fatalf("%s = %v, want %v", what, got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("%s = %v, want %v", what, got, want)
+		}
+	}
+}
+
+func testCreateSecret(t *testing.T, ctx context.Context, storage local.Storage) {
+	if _, err := storage.GetSecret(ctx, "missing"); !errors.Is(err, local.ErrNoSecretFound) {
+		t.Errorf("GetSecret(missing) error = %v, want %v", err, local.ErrNoSecretFound)
+	}
+
+	data := newSecret("data")
+	data.UpdatedAt = timestamppb.New(time.Unix(1600000100, 0))
+	data.Revision = 3
+	data.Status.Synced = true
+
+	login := newLogin("login")
+
+	// Secrets are created alive whatever the status is
+	deleted := newSecret("deleted")
+	deleted.DeletedAt = timestamppb.Now()
+	deleted.Status.Deleted = true
+
+	create(t, ctx, storage, data, login, deleted)
+
+	assertEqual(t, "GetSecret(data)", data, get(t, ctx, storage, "data"))
+	assertEqual(t, "GetSecret(login)", login, get(t, ctx, storage, "login"))
+
+	created := get(t, ctx, storage, "deleted")
+	if created.GetStatus().GetDeleted() || created.GetDeletedAt() != nil {
+		t.Errorf("GetSecret(deleted) = %v, want alive secret", created)
+	}
+
+	if _, err := storage.CreateSecret(ctx, newSecret("data")); err == nil {
+		t.Errorf("CreateSecret(data) of existing secret succeeded")
+	}
+
+	// The stored secret doesn't share memory with the caller
+	want := proto.Clone(data).(*pb.Secret)
+	data.Labels["site"] = "changed"
+	data.Data[0] = 'X'
+	assertEqual(t, "GetSecret(data) after changing the created secret", want, get(t, ctx, storage, "data"))
+}
+
+func testCreateSecrets(t *testing.T, ctx context.Context, storage local.Storage) {
+	ids, err := storage.CreateSecrets(ctx, []*pb.Secret{newSecret("first"), newLogin("second")})
+	if err != nil {
+		t.Fatalf("CreateSecrets() error = %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "first" || ids[1] != "second" {
+		t.Fatalf("CreateSecrets() = %v, want [first second]", ids)
+	}
+
+	assertEqual(t, "GetSecret(first)", newSecret("first"), get(t, ctx, storage, "first"))
+	assertEqual(t, "GetSecret(second)", newLogin("second"), get(t, ctx, storage, "second"))
+
+	// The batch with an existing secret is rejected as a whole
+	if _, err := storage.CreateSecrets(ctx, []*pb.Secret{newSecret("third"), newSecret("first")}); err == nil {
+		t.Fatal("CreateSecrets() with existing secret succeeded")
+	}
+	if _, err := storage.GetSecret(ctx, "third"); !errors.Is(err, local.ErrNoSecretFound) {
+		t.Errorf("GetSecret(third) of rejected batch error = %v, want %v", err, local.ErrNoSecretFound)
+	}
+
+	// The batch with duplicates is rejected as a whole
+	if _, err := storage.CreateSecrets(ctx, []*pb.Secret{newSecret("fourth"), newSecret("fourth")}); err == nil {
+		t.Fatal("CreateSecrets() with duplicate secrets succeeded")
+	}
+	if _, err := storage.GetSecret(ctx, "fourth"); !errors.Is(err, local.ErrNoSecretFound) {
+		t.Errorf("GetSecret(fourth) of rejected batch error = %v, want %v", err, local.ErrNoSecretFound)
+	}
+
+	if ids, err := storage.CreateSecrets(ctx, nil); err != nil || len(ids) != 0 {
+		t.Errorf("CreateSecrets(nil) = %v, %v, want no secrets", ids, err)
+	}
+}
+
+func testRestoreSecrets(t *testing.T, ctx context.Context, storage local.Storage) {
+	alive := newLogin("alive")
+	alive.UpdatedAt = timestamppb.New(time.Unix(1600000200, 0))
+
+	deleted := newSecret("deleted")
+	deleted.DeletedAt = timestamppb.New(time.Unix(1600000300, 0))
+	deleted.Status.Deleted = true
+
+	versions := []*pb.SecretVersion{
+		{Version: 2, Secret: newLogin("alive"), ArchivedAt: timestamppb.New(time.Unix(1600000150, 0))},
+		{Version: 1, Secret: newSecret("alive"), ArchivedAt: timestamppb.New(time.Unix(1600000100, 0))},
+	}
+
+	if err := storage.RestoreSecrets(ctx, []*pb.Secret{alive, deleted}, versions); err != nil {
+		t.Fatalf("RestoreSecrets() error = %v", err)
+	}
+
+	assertEqual(t, "GetSecret(alive)", alive, get(t, ctx, storage, "alive"))
+	assertEqual(t, "GetSecret(deleted)", deleted, get(t, ctx, storage, "deleted"))
+
+	restored, err := storage.ListSecretVersions(ctx, "alive")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(restored) != 2 {
+		t.Fatalf("ListSecretVersions() = %v, want 2 versions", restored)
+	}
+	for i, version := range versions {
+		assertEqual(t, "ListSecretVersions() version", version, restored[i])
+	}
+
+	// Versions continue after the restored ones
+	changed := proto.Clone(alive).(*pb.Secret)
+	changed.GetLogin().Password = []byte("changed")
+	update(t, ctx, storage, changed)
+
+	if _, err := storage.GetSecretVersion(ctx, "alive", 3); err != nil {
+		t.Errorf("GetSecretVersion(3) after update error = %v", err)
+	}
+
+	// The batch with an existing secret is rejected as a whole
+	err = storage.RestoreSecrets(ctx, []*pb.Secret{newSecret("new"), newSecret("alive")}, nil)
+	if err == nil {
+		t.Fatal("RestoreSecrets() with existing secret succeeded")
+	}
+	if _, err := storage.GetSecret(ctx, "new"); !errors.Is(err, local.ErrNoSecretFound) {
+		t.Errorf("GetSecret(new) of rejected batch error = %v, want %v", err, local.ErrNoSecretFound)
+	}
+}
+
+func testListSecrets(t *testing.T, ctx context.Context, storage local.Storage) {
+	secrets, err := storage.ListSecrets(ctx)
+	if err != nil {
+		t.Fatalf("ListSecrets() error = %v", err)
+	}
+	if len(secrets) != 0 {
+		t.Fatalf("ListSecrets() of empty store = %v", ids(secrets))
+	}
+
+	synced := newSecret("synced")
+	synced.Status.Synced = true
+	create(t, ctx, storage, newSecret("first"), synced, newLogin("last"))
+
+	secrets, err = storage.ListSecrets(ctx)
+	if err != nil {
+		t.Fatalf("ListSecrets() error = %v", err)
+	}
+	assertIDs(t, "ListSecrets()", []string{"first", "synced", "last"}, secrets)
+	assertEqual(t, "ListSecrets()[2]", newLogin("last"), secrets[2])
+
+	unsynced, err := storage.ListUnsyncedSecrets(ctx)
+	if err != nil {
+		t.Fatalf("ListUnsyncedSecrets() error = %v", err)
+	}
+	assertIDs(t, "ListUnsyncedSecrets()", []string{"first", "last"}, unsynced)
+}
+
+func testQuerySecrets(t *testing.T, ctx context.Context, storage local.Storage) {
+	labeled := func(id string, createdAt int64, labels map[string]string) *pb.Secret {
+		secret := newSecret(id)
+		secret.CreatedAt = timestamppb.New(time.Unix(createdAt, 0))
+		secret.Labels = labels
+
+		return secret
+	}
+
+	api := labeled("api", 1600000003, map[string]string{"env": "prod", "app": "api", "team": "web"})
+	web := labeled("web", 1600000001, map[string]string{"env": "prod", "app": "web", "team": "infra"})
+	db := labeled("db", 1600000002, map[string]string{"env": "dev", "app": "db"})
+	bare := labeled("bare", 1600000004, nil)
+	deleted := labeled("deleted", 1600000000, map[string]string{"env": "prod"})
+	create(t, ctx, storage, api, web, db, bare, deleted)
+
+	// web is updated last, bare is never updated
+	web.UpdatedAt = timestamppb.New(time.Unix(1600000010, 500000000))
+	update(t, ctx, storage, web)
+	db.UpdatedAt = timestamppb.New(time.Unix(1600000010, 0))
+	update(t, ctx, storage, db)
+	api.UpdatedAt = timestamppb.New(time.Unix(1600000005, 0))
+	update(t, ctx, storage, api)
+
+	if err := storage.DeleteSecret(ctx, deleted); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+
+	tests := []struct {
+		name  string
+		query local.SecretsQuery
+		want  []string
+		total int
+	}{
+		{"all in creation order", local.SecretsQuery{}, []string{"api", "web", "db", "bare"}, 4},
+		{"deleted", local.SecretsQuery{Deleted: true}, []string{"deleted"}, 1},
+		{"equals", local.SecretsQuery{Selector: mustParse(t, "env=prod")}, []string{"api", "web"}, 2},
+		{"not equals matches missing labels", local.SecretsQuery{Selector: mustParse(t, "team!=infra")},
+			[]string{"api", "db", "bare"}, 3},
+		{"in", local.SecretsQuery{Selector: mustParse(t, "app in (db,web)")}, []string{"web", "db"}, 2},
+		{"notin matches missing labels", local.SecretsQuery{Selector: mustParse(t, "app notin (db,web)")},
+			[]string{"api", "bare"}, 2},
+		{"exists", local.SecretsQuery{Selector: mustParse(t, "team")}, []string{"api", "web"}, 2},
+		{"does not exist", local.SecretsQuery{Selector: mustParse(t, "!team")}, []string{"db", "bare"}, 2},
+		{"all requirements", local.SecretsQuery{Selector: mustParse(t, "env=prod,team!=infra,app in (api,web)")},
+			[]string{"api"}, 1},
+		{"sort by created", local.SecretsQuery{SortBy: pb.SortBy_SORT_CREATED_AT},
+			[]string{"web", "db", "api", "bare"}, 4},
+		{"sort by created descending", local.SecretsQuery{SortBy: pb.SortBy_SORT_CREATED_AT, Descending: true},
+			[]string{"bare", "api", "db", "web"}, 4},
+		{"sort by updated", local.SecretsQuery{SortBy: pb.SortBy_SORT_UPDATED_AT},
+			[]string{"bare", "api", "db", "web"}, 4},
+		{"descending creation order", local.SecretsQuery{Descending: true}, []string{"bare", "db", "web", "api"}, 4},
+		{"page", local.SecretsQuery{SortBy: pb.SortBy_SORT_CREATED_AT, Offset: 1, Limit: 2}, []string{"db", "api"}, 4},
+		{"page past the end", local.SecretsQuery{Offset: 10, Limit: 2}, nil, 4},
+		{"page of selected", local.SecretsQuery{Selector: mustParse(t, "env"), Limit: 2}, []string{"api", "web"}, 3},
+	}
+
+	for _, tt := range tests {
+		secrets, total, err := storage.QuerySecrets(ctx, tt.query)
+		if err != nil {
+			t.Fatalf("QuerySecrets(%s) error = %v", tt.name, err)
+		}
+
+		assertIDs(t, "QuerySecrets("+tt.name+")", tt.want, secrets)
+		if total != tt.total {
+			t.Errorf("QuerySecrets(%s) total = %d, want %d", tt.name, total, tt.total)
+		}
+	}
+}
+
+func mustParse(t *testing.T, s string) selector.Selector {
+	t.Helper()
+
+	parsed, err := selector.Parse(s)
+	if err != nil {
+		t.Fatalf("Parse(%q) error = %v", s, err)
+	}
+
+	return parsed
+}
+
+func testUpdateSecret(t *testing.T, ctx context.Context, storage local.Storage) {
+	create(t, ctx, storage, newSecret("secret"))
+
+	updated := newSecret("secret")
+	updated.CreatedAt = timestamppb.Now()
+	updated.UpdatedAt = timestamppb.New(time.Unix(1600000200, 0))
+	updated.Labels = map[string]string{"site": "example.org"}
+	updated.Data = []byte("updated")
+	updated.Revision = 2
+	updated.Status.Synced = true
+	update(t, ctx, storage, updated)
+
+	// The creation time is kept
+	want := proto.Clone(updated).(*pb.Secret)
+	want.CreatedAt = newSecret("secret").GetCreatedAt()
+	assertEqual(t, "GetSecret() after update", want, get(t, ctx, storage, "secret"))
+
+	// Updating a missing secret is a no-op
+	update(t, ctx, storage, newSecret("missing"))
+	if _, err := storage.GetSecret(ctx, "missing"); !errors.Is(err, local.ErrNoSecretFound) {
+		t.Errorf("GetSecret(missing) after update error = %v, want %v", err, local.ErrNoSecretFound)
+	}
+
+	// Updating a deleted secret restores it
+	if err := storage.DeleteSecret(ctx, want); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+	update(t, ctx, storage, want)
+	restored := get(t, ctx, storage, "secret")
+	if restored.GetStatus().GetDeleted() || restored.GetDeletedAt() != nil {
+		t.Errorf("GetSecret() after update of deleted secret = %v, want alive secret", restored)
+	}
+}
+
+func testReplaceSecret(t *testing.T, ctx context.Context, storage local.Storage) {
+	create(t, ctx, storage, newLogin("secret"))
+
+	replaced := get(t, ctx, storage, "secret")
+	replaced.GetLogin().TOTPSeed = []byte("counter advanced")
+	replaced.UpdatedAt = timestamppb.New(time.Unix(1600000200, 0))
+	if err := storage.ReplaceSecret(ctx, replaced); err != nil {
+		t.Fatalf("ReplaceSecret() error = %v", err)
+	}
+	assertEqual(t, "GetSecret() after replace", replaced, get(t, ctx, storage, "secret"))
+
+	// The replaced content isn't archived
+	versions, err := storage.ListSecretVersions(ctx, "secret")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 0 {
+		t.Errorf("ListSecretVersions() after replace = %v, want none", versions)
+	}
+}
+
+func testMarkSecretSynced(t *testing.T, ctx context.Context, storage local.Storage) {
+	create(t, ctx, storage, newSecret("unchanged"), newLogin("changed"), newSecret("deleted"))
+
+	pushed := get(t, ctx, storage, "unchanged")
+	if err := storage.MarkSecretSynced(ctx, pushed, 1); err != nil {
+		t.Fatalf("MarkSecretSynced(unchanged) error = %v", err)
+	}
+
+	secret := get(t, ctx, storage, "unchanged")
+	if !secret.GetStatus().GetSynced() || secret.GetRevision() != 1 {
+		t.Errorf("GetSecret(unchanged) = %v, want synced with revision 1", secret)
+	}
+
+	// The secret changed after being pushed stays unsynced
+	pushed = get(t, ctx, storage, "changed")
+	changed := proto.Clone(pushed).(*pb.Secret)
+	changed.GetLogin().Password = []byte("changed")
+	changed.UpdatedAt = timestamppb.Now()
+	update(t, ctx, storage, changed)
+
+	if err := storage.MarkSecretSynced(ctx, pushed, 1); err != nil {
+		t.Fatalf("MarkSecretSynced(changed) error = %v", err)
+	}
+
+	secret = get(t, ctx, storage, "changed")
+	if secret.GetStatus().GetSynced() || secret.GetRevision() != 1 {
+		t.Errorf("GetSecret(changed) = %v, want unsynced with revision 1", secret)
+	}
+
+	// The secret deleted after being pushed stays unsynced
+	pushed = get(t, ctx, storage, "deleted")
+	if err := storage.DeleteSecret(ctx, pushed); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+
+	if err := storage.MarkSecretSynced(ctx, pushed, 1); err != nil {
+		t.Fatalf("MarkSecretSynced(deleted) error = %v", err)
+	}
+
+	secret = get(t, ctx, storage, "deleted")
+	if secret.GetStatus().GetSynced() || !secret.GetStatus().GetDeleted() {
+		t.Errorf("GetSecret(deleted) = %v, want unsynced deleted secret", secret)
+	}
+}
+
+func testDeleteSecret(t *testing.T, ctx context.Context, storage local.Storage) {
+	create(t, ctx, storage, newLogin("secret"), newSecret("now"))
+
+	deletedAt := timestamppb.New(time.Unix(1600000300, 0))
+	deleted := newLogin("secret")
+	deleted.DeletedAt = deletedAt
+	deleted.Status.Synced = true
+	if err := storage.DeleteSecret(ctx, deleted); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+
+	// The content is kept in the trash
+	want := newLogin("secret")
+	want.DeletedAt = deletedAt
+	want.Status = &pb.Status{Synced: true, Deleted: true}
+	assertEqual(t, "GetSecret() after delete", want, get(t, ctx, storage, "secret"))
+
+	// The deletion time defaults to now
+	before := time.Now().Add(-time.Second)
+	if err := storage.DeleteSecret(ctx, newSecret("now")); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+
+	secret := get(t, ctx, storage, "now")
+	if secret.GetDeletedAt() == nil || secret.GetDeletedAt().AsTime().Before(before) {
+		t.Errorf("GetSecret() after delete DeletedAt = %v, want now", secret.GetDeletedAt())
+	}
+}
+
+func testPurgeSecret(t *testing.T, ctx context.Context, storage local.Storage) {
+	create(t, ctx, storage, newSecret("purged"), newSecret("kept"))
+
+	for _, id := range []string{"purged", "kept"} {
+		secret := newSecret(id)
+		secret.Data = []byte("updated")
+		update(t, ctx, storage, secret)
+
+		if err := storage.SaveSecretConflict(ctx, newSecret(id)); err != nil {
+			t.Fatalf("SaveSecretConflict() error = %v", err)
+		}
+	}
+
+	if err := storage.PurgeSecret(ctx, "purged"); err != nil {
+		t.Fatalf("PurgeSecret() error = %v", err)
+	}
+
+	if _, err := storage.GetSecret(ctx, "purged"); !errors.Is(err, local.ErrNoSecretFound) {
+		t.Errorf("GetSecret() after purge error = %v, want %v", err, local.ErrNoSecretFound)
+	}
+	if _, err := storage.GetSecretConflict(ctx, "purged"); !errors.Is(err, local.ErrNoSecretConflictFound) {
+		t.Errorf("GetSecretConflict() after purge error = %v, want %v", err, local.ErrNoSecretConflictFound)
+	}
+
+	versions, err := storage.ListSecretVersions(ctx, "purged")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 0 {
+		t.Errorf("ListSecretVersions() after purge = %v, want none", versions)
+	}
+
+	// Other secrets are untouched
+	secrets, err := storage.ListSecrets(ctx)
+	if err != nil {
+		t.Fatalf("ListSecrets() error = %v", err)
+	}
+	assertIDs(t, "ListSecrets() after purge", []string{"kept"}, secrets)
+
+	versions, err = storage.ListSecretVersions(ctx, "kept")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 1 {
+		t.Errorf("ListSecretVersions(kept) = %v, want one version", versions)
+	}
+
+	if err := storage.PurgeSecret(ctx, "missing"); err != nil {
+		t.Errorf("PurgeSecret(missing) error = %v", err)
+	}
+}
+
+func testSecretVersions(t *testing.T, ctx context.Context, storage local.Storage) {
+	create(t, ctx, storage, newLogin("secret"))
+
+	versions, err := storage.ListSecretVersions(ctx, "secret")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 0 {
+		t.Fatalf("ListSecretVersions() of new secret = %v, want none", versions)
+	}
+
+	// Only content changes are archived, the label-only update keeps the sealed content as is
+	labeled := newLogin("secret")
+	labeled.Labels = map[string]string{"site": "example.org"}
+	update(t, ctx, storage, labeled)
+
+	versions, err = storage.ListSecretVersions(ctx, "secret")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 0 {
+		t.Fatalf("ListSecretVersions() after label-only update = %v, want none", versions)
+	}
+
+	previous := labeled
+	for i := 1; i <= 3; i++ {
+		changed := proto.Clone(previous).(*pb.Secret)
+		changed.GetLogin().Password = []byte{byte('0' + i)}
+		changed.UpdatedAt = timestamppb.New(time.Unix(int64(1600000000+i), 0))
+		update(t, ctx, storage, changed)
+		previous = changed
+	}
+
+	versions, err = storage.ListSecretVersions(ctx, "secret")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 3 {
+		t.Fatalf("ListSecretVersions() = %v, want 3 versions", versions)
+	}
+	for i, version := range versions {
+		if want := uint64(3 - i); version.GetVersion() != want {
+			t.Errorf("ListSecretVersions()[%d].Version = %d, want %d", i, version.GetVersion(), want)
+		}
+		if version.GetArchivedAt() == nil {
+			t.Errorf("ListSecretVersions()[%d].ArchivedAt is empty", i)
+		}
+	}
+
+	first, err := storage.GetSecretVersion(ctx, "secret", 1)
+	if err != nil {
+		t.Fatalf("GetSecretVersion() error = %v", err)
+	}
+
+	want := proto.Clone(labeled).(*pb.Secret)
+	want.Status = &pb.Status{}
+	assertEqual(t, "GetSecretVersion(1).Secret", want, first.GetSecret())
+
+	if _, err := storage.GetSecretVersion(ctx, "secret", 4); !errors.Is(err, local.ErrNoSecretVersionFound) {
+		t.Errorf("GetSecretVersion(4) error = %v, want %v", err, local.ErrNoSecretVersionFound)
+	}
+
+	// Nothing is archived while the secret is in the trash
+	if err := storage.DeleteSecret(ctx, previous); err != nil {
+		t.Fatalf("DeleteSecret() error = %v", err)
+	}
+	restored := proto.Clone(previous).(*pb.Secret)
+	restored.Data = []byte("restored")
+	update(t, ctx, storage, restored)
+
+	if err := storage.PruneSecretVersions(ctx, "secret", 0); err != nil {
+		t.Fatalf("PruneSecretVersions(0) error = %v", err)
+	}
+	if err := storage.PruneSecretVersions(ctx, "secret", 2); err != nil {
+		t.Fatalf("PruneSecretVersions(2) error = %v", err)
+	}
+
+	versions, err = storage.ListSecretVersions(ctx, "secret")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 2 || versions[0].GetVersion() != 3 || versions[1].GetVersion() != 2 {
+		t.Errorf("ListSecretVersions() after prune = %v, want versions 3 and 2", versions)
+	}
+}
+
+func testSecretConflicts(t *testing.T, ctx context.Context, storage local.Storage) {
+	if _, err := storage.GetSecretConflict(ctx, "missing"); !errors.Is(err, local.ErrNoSecretConflictFound) {
+		t.Errorf("GetSecretConflict(missing) error = %v, want %v", err, local.ErrNoSecretConflictFound)
+	}
+
+	remote := newLogin("first")
+	remote.UpdatedAt = timestamppb.New(time.Unix(1600000400, 0))
+	remote.Revision = 2
+	if err := storage.SaveSecretConflict(ctx, remote); err != nil {
+		t.Fatalf("SaveSecretConflict() error = %v", err)
+	}
+
+	deleted := newSecret("second")
+	deleted.DeletedAt = timestamppb.New(time.Unix(1600000500, 0))
+	deleted.Revision = 5
+	deleted.Status.Deleted = true
+	if err := storage.SaveSecretConflict(ctx, deleted); err != nil {
+		t.Fatalf("SaveSecretConflict() error = %v", err)
+	}
+
+	conflict, err := storage.GetSecretConflict(ctx, "first")
+	if err != nil {
+		t.Fatalf("GetSecretConflict() error = %v", err)
+	}
+
+	// The remote side is synced by definition
+	want := proto.Clone(remote).(*pb.Secret)
+	want.Status.Synced = true
+	assertEqual(t, "GetSecretConflict().Remote", want, conflict.GetRemote())
+	if conflict.GetDetectedAt() == nil {
+		t.Fatalf("GetSecretConflict().DetectedAt is empty")
+	}
+
+	// Saving the conflict again replaces the remote side and keeps the time of detection
+	remote.Revision = 3
+	if err := storage.SaveSecretConflict(ctx, remote); err != nil {
+		t.Fatalf("SaveSecretConflict() error = %v", err)
+	}
+
+	conflicts, err := storage.ListSecretConflicts(ctx)
+	if err != nil {
+		t.Fatalf("ListSecretConflicts() error = %v", err)
+	}
+	if len(conflicts) != 2 {
+		t.Fatalf("ListSecretConflicts() = %v, want 2 conflicts", conflicts)
+	}
+	if conflicts[0].GetRemote().GetRevision() != 3 {
+		t.Errorf("ListSecretConflicts()[0].Remote.Revision = %d, want 3", conflicts[0].GetRemote().GetRevision())
+	}
+	assertEqual(t, "ListSecretConflicts()[0].DetectedAt", conflict.GetDetectedAt(), conflicts[0].GetDetectedAt())
+
+	want = proto.Clone(deleted).(*pb.Secret)
+	want.Status.Synced = true
+	assertEqual(t, "ListSecretConflicts()[1].Remote", want, conflicts[1].GetRemote())
+
+	if err := storage.DeleteSecretConflict(ctx, "first"); err != nil {
+		t.Fatalf("DeleteSecretConflict() error = %v", err)
+	}
+	if _, err := storage.GetSecretConflict(ctx, "first"); !errors.Is(err, local.ErrNoSecretConflictFound) {
+		t.Errorf("GetSecretConflict() after delete error = %v, want %v", err, local.ErrNoSecretConflictFound)
+	}
+}
+
+func testAccounts(t *testing.T, ctx context.Context, storage local.Storage) {
+	if _, err := storage.GetAccount(ctx); !errors.Is(err, local.ErrAccountNotExists) {
+		t.Errorf("GetAccount() error = %v, want %v", err, local.ErrAccountNotExists)
+	}
+	if _, err := storage.GetSyncCursor(ctx); !errors.Is(err, local.ErrAccountNotExists) {
+		t.Errorf("GetSyncCursor() error = %v, want %v", err, local.ErrAccountNotExists)
+	}
+
+	account := &pb.Account{
+		ID:            "account",
+		ServerAddress: "localhost:8080",
+		UserName:      "user",
+		UserPassword:  []byte("password"),
+	}
+	if _, err := storage.CreateAccount(ctx, account); err != nil {
+		t.Fatalf("CreateAccount() error = %v", err)
+	}
+
+	got, err := storage.GetAccount(ctx)
+	if err != nil {
+		t.Fatalf("GetAccount() error = %v", err)
+	}
+	assertEqual(t, "GetAccount()", account, got)
+
+	assertCursor := func(what string, want uint64) {
+		t.Helper()
+
+		cursor, err := storage.GetSyncCursor(ctx)
+		if err != nil {
+			t.Fatalf("GetSyncCursor() error = %v", err)
+		}
+		if cursor != want {
+			t.Errorf("GetSyncCursor() %s = %d, want %d", what, cursor, want)
+		}
+	}
+
+	assertCursor("of new account", 0)
+
+	if err := storage.UpdateSyncCursor(ctx, 42); err != nil {
+		t.Fatalf("UpdateSyncCursor() error = %v", err)
+	}
+	assertCursor("after update", 42)
+
+	// The cursor is kept while the server and the user stay the same
+	account.Registered = true
+	account.UserPassword = []byte("changed")
+	if err := storage.UpdateAccount(ctx, account); err != nil {
+		t.Fatalf("UpdateAccount() error = %v", err)
+	}
+
+	got, err = storage.GetAccount(ctx)
+	if err != nil {
+		t.Fatalf("GetAccount() error = %v", err)
+	}
+	assertEqual(t, "GetAccount() after update", account, got)
+	assertCursor("after account update", 42)
+
+	account.ServerAddress = "localhost:9090"
+	if err := storage.UpdateAccount(ctx, account); err != nil {
+		t.Fatalf("UpdateAccount() error = %v", err)
+	}
+	assertCursor("after server change", 0)
+
+	if err := storage.DeleteAccount(ctx); err != nil {
+		t.Fatalf("DeleteAccount() error = %v", err)
+	}
+	if _, err := storage.GetAccount(ctx); !errors.Is(err, local.ErrAccountNotExists) {
+		t.Errorf("GetAccount() after delete error = %v, want %v", err, local.ErrAccountNotExists)
+	}
+}
+
+func testVault(t *testing.T, ctx context.Context, storage local.Storage) {
+	if _, err := storage.GetVault(ctx); !errors.Is(err, local.ErrNoVault) {
+		t.Errorf("GetVault() error = %v, want %v", err, local.ErrNoVault)
+	}
+
+	params, err := encryption.NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatalf("NewKDFParams() error = %v", err)
+	}
+
+	vault := &local.VaultInfo{
+		KDF:        params,
+		Verifier:   []byte("verifier"),
+		WrappedKey: []byte("wrapped"),
+	}
+	if err := storage.CreateVault(ctx, vault); err != nil {
+		t.Fatalf("CreateVault() error = %v", err)
+	}
+
+	assertVault := func(what string, want *local.VaultInfo) {
+		t.Helper()
+
+		got, err := storage.GetVault(ctx)
+		if err != nil {
+			t.Fatalf("GetVault() error = %v", err)
+		}
+		if got.KDF.Algorithm != want.KDF.Algorithm || string(got.KDF.Salt) != string(want.KDF.Salt) ||
+			got.KDF.Time != want.KDF.Time || got.KDF.Memory != want.KDF.Memory || got.KDF.Threads != want.KDF.Threads {
+			t.Errorf("GetVault() %s KDF = %+v, want %+v", what, got.KDF, want.KDF)
+		}
+		if string(got.Verifier) != string(want.Verifier) || string(got.WrappedKey) != string(want.WrappedKey) ||
+			string(got.RecoveryKey) != string(want.RecoveryKey) {
+			t.Errorf("GetVault() %s = %+v, want %+v", what, got, want)
+		}
+	}
+
+	assertVault("after create", vault)
+
+	if err := storage.CreateVault(ctx, vault); err == nil {
+		t.Errorf("CreateVault() of existing vault succeeded")
+	}
+
+	vault.WrappedKey = []byte("rewrapped")
+	vault.RecoveryKey = []byte("recovery")
+	if err := storage.UpdateVault(ctx, vault); err != nil {
+		t.Fatalf("UpdateVault() error = %v", err)
+	}
+	assertVault("after update", vault)
+}
+
+func testRekey(t *testing.T, ctx context.Context, storage local.Storage) {
+	params, err := encryption.NewKDFParams(1, 1024, 1)
+	if err != nil {
+		t.Fatalf("NewKDFParams() error = %v", err)
+	}
+
+	if err := storage.CreateVault(ctx, &local.VaultInfo{KDF: params, Verifier: []byte("verifier"), WrappedKey: []byte("wrapped")}); err != nil {
+		t.Fatalf("CreateVault() error = %v", err)
+	}
+
+	login := newLogin("login")
+	create(t, ctx, storage, login, newSecret("data"))
+
+	changed := proto.Clone(login).(*pb.Secret)
+	changed.GetLogin().Password = []byte("changed")
+	update(t, ctx, storage, changed)
+
+	if err := storage.SaveSecretConflict(ctx, newSecret("data")); err != nil {
+		t.Fatalf("SaveSecretConflict() error = %v", err)
+	}
+
+	// A failed conversion leaves the store untouched
+	failed := errors.New("conversion failed")
+	err = storage.Rekey(ctx, &local.VaultInfo{KDF: params, Verifier: []byte("verifier"), WrappedKey: []byte("failed")}, func(data []byte) ([]byte, error) {
+		if string(data) == "changed" {
+			return nil, failed
+		}
+
+		return append([]byte("rekeyed "), data...), nil
+	})
+	if !errors.Is(err, failed) {
+		t.Fatalf("Rekey() of failing conversion error = %v, want %v", err, failed)
+	}
+	assertEqual(t, "GetSecret() after failed Rekey()", changed, get(t, ctx, storage, "login"))
+
+	vault := &local.VaultInfo{KDF: params, Verifier: []byte("verifier"), WrappedKey: []byte("rekeyed")}
+	err = storage.Rekey(ctx, vault, func(data []byte) ([]byte, error) {
+		return append([]byte("rekeyed "), data...), nil
+	})
+	if err != nil {
+		t.Fatalf("Rekey() error = %v", err)
+	}
+
+	if got := string(get(t, ctx, storage, "login").GetLogin().GetPassword()); got != "rekeyed changed" {
+		t.Errorf("GetSecret() password = %q, want %q", got, "rekeyed changed")
+	}
+	if got := string(get(t, ctx, storage, "data").GetData()); got != "rekeyed data data" {
+		t.Errorf("GetSecret() data = %q, want %q", got, "rekeyed data data")
+	}
+
+	versions, err := storage.ListSecretVersions(ctx, "login")
+	if err != nil {
+		t.Fatalf("ListSecretVersions() error = %v", err)
+	}
+	if len(versions) != 1 || string(versions[0].GetSecret().GetLogin().GetPassword()) != "rekeyed password" {
+		t.Errorf("ListSecretVersions() = %v, want the version with the rekeyed password", versions)
+	}
+
+	conflict, err := storage.GetSecretConflict(ctx, "data")
+	if err != nil {
+		t.Fatalf("GetSecretConflict() error = %v", err)
+	}
+	if got := string(conflict.GetRemote().GetData()); got != "rekeyed data data" {
+		t.Errorf("GetSecretConflict() data = %q, want %q", got, "rekeyed data data")
+	}
+
+	got, err := storage.GetVault(ctx)
+	if err != nil {
+		t.Fatalf("GetVault() error = %v", err)
+	}
+	if string(got.WrappedKey) != "rekeyed" || string(got.Verifier) != "verifier" {
+		t.Errorf("GetVault() = %+v, want %+v", got, vault)
+	}
+}
@@ -0,0 +1,669 @@
+package local
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+const MemoryBackend = "memory"
+
+var (
+	_ Storage = (*memoryStorage)(nil)
+
+	ErrSecretExists = errors.New("secret already exists")
+	ErrVaultExists  = errors.New("vault already exists")
+)
+
+// memoryStorage keeps the store in memory only, everything is lost once it's closed.
+// Secrets are copied in and out, so callers never share them with the store
+type memoryStorage struct {
+	mu sync.RWMutex
+	// ids and conflictIDs keep the order of creation
+	ids         []string
+	secrets     map[string]*pb.Secret
+	versions    map[string][]*pb.SecretVersion
+	conflictIDs []string
+	conflicts   map[string]*pb.SecretConflict
+	account     *pb.Account
+	syncCursor  uint64
+	vault       *VaultInfo
+}
+
+func init() {
+	Register(MemoryBackend, func(string) (Storage, error) {
+		return NewMemoryStorage(), nil
+	})
+}
+
+func NewMemoryStorage() *memoryStorage {
+	return &memoryStorage{
+		secrets:   make(map[string]*pb.Secret),
+		versions:  make(map[string][]*pb.SecretVersion),
+		conflicts: make(map[string]*pb.SecretConflict),
+	}
+}
+
+func (ms *memoryStorage) CreateSecret(ctx context.Context, secret *pb.Secret) (string, error) {
+	ids, err := ms.CreateSecrets(ctx, []*pb.Secret{secret})
+	if err != nil {
+		return "", err
+	}
+
+	return ids[0], nil
+}
+
+// CreateSecrets creates all secrets or none of them
+func (ms *memoryStorage) CreateSecrets(_ context.Context, secrets []*pb.Secret) ([]string, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	batch := make(map[string]struct{}, len(secrets))
+	for _, secret := range secrets {
+		if _, ok := ms.secrets[secret.GetID()]; ok {
+			return nil, ErrSecretExists
+		}
+		if _, ok := batch[secret.GetID()]; ok {
+			return nil, ErrSecretExists
+		}
+		batch[secret.GetID()] = struct{}{}
+	}
+
+	ids := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		// Secrets are created alive, the same way as the SQLite backend does
+		stored := cloneSecret(secret)
+		stored.DeletedAt = nil
+		stored.Status = &pb.Status{Synced: secret.GetStatus().GetSynced()}
+
+		ms.secrets[secret.GetID()] = stored
+		ms.ids = append(ms.ids, secret.GetID())
+		ids = append(ids, secret.GetID())
+	}
+
+	return ids, nil
+}
+
+// RestoreSecrets restores all secrets and their versions or none of them
+func (ms *memoryStorage) RestoreSecrets(_ context.Context, secrets []*pb.Secret, versions []*pb.SecretVersion) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	batch := make(map[string]struct{}, len(secrets))
+	for _, secret := range secrets {
+		if _, ok := ms.secrets[secret.GetID()]; ok {
+			return ErrSecretExists
+		}
+		if _, ok := batch[secret.GetID()]; ok {
+			return ErrSecretExists
+		}
+		batch[secret.GetID()] = struct{}{}
+	}
+
+	for _, secret := range secrets {
+		stored := cloneSecret(secret)
+		stored.Status = &pb.Status{
+			Synced:  secret.GetStatus().GetSynced(),
+			Deleted: secret.GetStatus().GetDeleted(),
+		}
+		if !stored.Status.Deleted {
+			stored.DeletedAt = nil
+		} else if stored.DeletedAt == nil {
+			stored.DeletedAt = timestamppb.Now()
+		}
+
+		ms.secrets[secret.GetID()] = stored
+		ms.ids = append(ms.ids, secret.GetID())
+	}
+
+	restored := make(map[string]struct{})
+	for _, version := range versions {
+		archived := proto.Clone(version).(*pb.SecretVersion)
+		if archived.Secret == nil {
+			archived.Secret = &pb.Secret{}
+		}
+		archived.Secret.DeletedAt = nil
+		archived.Secret.Status = &pb.Status{}
+		archived.Secret.Revision = 0
+		if archived.ArchivedAt == nil {
+			archived.ArchivedAt = timestamppb.Now()
+		}
+
+		id := archived.GetSecret().GetID()
+		ms.versions[id] = append(ms.versions[id], archived)
+		restored[id] = struct{}{}
+	}
+
+	// Versions are kept in the order of archiving
+	for id := range restored {
+		versions := ms.versions[id]
+		sort.Slice(versions, func(i, j int) bool {
+			return versions[i].GetVersion() < versions[j].GetVersion()
+		})
+	}
+
+	return nil
+}
+
+func (ms *memoryStorage) ListSecrets(_ context.Context) ([]*pb.Secret, error) {
+	return ms.listSecrets(func(*pb.Secret) bool { return true }), nil
+}
+
+func (ms *memoryStorage) ListUnsyncedSecrets(_ context.Context) ([]*pb.Secret, error) {
+	return ms.listSecrets(func(secret *pb.Secret) bool { return !secret.GetStatus().GetSynced() }), nil
+}
+
+// QuerySecrets evaluates the selector on secrets in memory
+func (ms *memoryStorage) QuerySecrets(_ context.Context, query SecretsQuery) ([]*pb.Secret, int, error) {
+	secrets := ms.listSecrets(func(secret *pb.Secret) bool {
+		return secret.GetStatus().GetDeleted() == query.Deleted && query.Selector.Matches(secret.GetLabels())
+	})
+
+	// Ties keep the order of creation in the direction of sorting
+	if query.Descending {
+		for i, j := 0, len(secrets)-1; i < j; i, j = i+1, j-1 {
+			secrets[i], secrets[j] = secrets[j], secrets[i]
+		}
+	}
+
+	if query.SortBy != pb.SortBy_SORT_UNSPECIFIED {
+		sort.SliceStable(secrets, func(i, j int) bool {
+			left, right := sortTime(secrets[i], query.SortBy), sortTime(secrets[j], query.SortBy)
+			if query.Descending {
+				return left.After(right)
+			}
+
+			return left.Before(right)
+		})
+	}
+
+	total := len(secrets)
+	if query.Offset >= total {
+		return nil, total, nil
+	}
+	secrets = secrets[query.Offset:]
+
+	if query.Limit > 0 && query.Limit < len(secrets) {
+		secrets = secrets[:query.Limit]
+	}
+
+	return secrets, total, nil
+}
+
+// sortTime returns the time secrets are sorted by, secrets never updated are sorted by the creation time
+func sortTime(secret *pb.Secret, sortBy pb.SortBy) time.Time {
+	if sortBy == pb.SortBy_SORT_UPDATED_AT && secret.GetUpdatedAt() != nil {
+		return secret.GetUpdatedAt().AsTime()
+	}
+
+	return secret.GetCreatedAt().AsTime()
+}
+
+func (ms *memoryStorage) listSecrets(filter func(*pb.Secret) bool) []*pb.Secret {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	var secrets []*pb.Secret
+	for _, id := range ms.ids {
+		if secret := ms.secrets[id]; filter(secret) {
+			secrets = append(secrets, cloneSecret(secret))
+		}
+	}
+
+	return secrets
+}
+
+func (ms *memoryStorage) GetSecret(_ context.Context, id string) (*pb.Secret, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	secret, ok := ms.secrets[id]
+	if !ok {
+		return nil, ErrNoSecretFound
+	}
+
+	return cloneSecret(secret), nil
+}
+
+// UpdateSecret archives the current revision to the versions if data or payload changes,
+// the deleted status is taken from the secret, so updating a deleted secret restores it
+func (ms *memoryStorage) UpdateSecret(_ context.Context, secret *pb.Secret) error {
+	return ms.updateSecret(secret, true)
+}
+
+func (ms *memoryStorage) ReplaceSecret(_ context.Context, secret *pb.Secret) error {
+	return ms.updateSecret(secret, false)
+}
+
+func (ms *memoryStorage) updateSecret(secret *pb.Secret, archive bool) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	stored, ok := ms.secrets[secret.GetID()]
+	if !ok {
+		return nil
+	}
+
+	changed, err := contentChanged(stored, secret)
+	if err != nil {
+		return err
+	}
+
+	if archive && changed && !stored.GetStatus().GetDeleted() {
+		versions := ms.versions[secret.GetID()]
+
+		var version uint64 = 1
+		if len(versions) > 0 {
+			version = versions[len(versions)-1].GetVersion() + 1
+		}
+
+		archived := cloneSecret(stored)
+		archived.DeletedAt = nil
+		archived.Status = &pb.Status{}
+		archived.Revision = 0
+
+		ms.versions[secret.GetID()] = append(versions, &pb.SecretVersion{
+			Secret:     archived,
+			Version:    version,
+			ArchivedAt: timestamppb.Now(),
+		})
+	}
+
+	updated := cloneSecret(secret)
+	updated.CreatedAt = stored.GetCreatedAt()
+	updated.Status = &pb.Status{
+		Synced:  secret.GetStatus().GetSynced(),
+		Deleted: secret.GetStatus().GetDeleted(),
+	}
+	ms.secrets[secret.GetID()] = updated
+
+	return nil
+}
+
+// MarkSecretSynced compares the secret with the stored one to mark it synced only if it's unchanged
+func (ms *memoryStorage) MarkSecretSynced(_ context.Context, secret *pb.Secret, revision uint64) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	stored, ok := ms.secrets[secret.GetID()]
+	if !ok {
+		return nil
+	}
+
+	changed, err := contentChanged(stored, secret)
+	if err != nil {
+		return err
+	}
+
+	stored.Revision = revision
+	stored.Status.Synced = !changed &&
+		proto.Equal(stored.GetUpdatedAt(), secret.GetUpdatedAt()) &&
+		stored.GetStatus().GetDeleted() == secret.GetStatus().GetDeleted()
+
+	return nil
+}
+
+// DeleteSecret moves the secret to the trash, the content is kept until the secret is purged
+func (ms *memoryStorage) DeleteSecret(_ context.Context, secret *pb.Secret) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	stored, ok := ms.secrets[secret.GetID()]
+	if !ok {
+		return nil
+	}
+
+	deletedAt := secret.GetDeletedAt()
+	if deletedAt == nil {
+		deletedAt = timestamppb.Now()
+	}
+
+	stored.DeletedAt = proto.Clone(deletedAt).(*timestamppb.Timestamp)
+	stored.Status.Synced = secret.GetStatus().GetSynced()
+	stored.Status.Deleted = true
+
+	return nil
+}
+
+// PurgeSecret permanently removes the secret with its history
+func (ms *memoryStorage) PurgeSecret(_ context.Context, id string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	delete(ms.versions, id)
+	ms.deleteSecretConflict(id)
+
+	if _, ok := ms.secrets[id]; ok {
+		delete(ms.secrets, id)
+		ms.ids = removeID(ms.ids, id)
+	}
+
+	return nil
+}
+
+func (ms *memoryStorage) ListSecretVersions(_ context.Context, id string) ([]*pb.SecretVersion, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	stored := ms.versions[id]
+
+	var versions []*pb.SecretVersion
+	for i := len(stored) - 1; i >= 0; i-- {
+		versions = append(versions, proto.Clone(stored[i]).(*pb.SecretVersion))
+	}
+
+	return versions, nil
+}
+
+func (ms *memoryStorage) GetSecretVersion(_ context.Context, id string, version uint64) (*pb.SecretVersion, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	for _, secretVersion := range ms.versions[id] {
+		if secretVersion.GetVersion() == version {
+			return proto.Clone(secretVersion).(*pb.SecretVersion), nil
+		}
+	}
+
+	return nil, ErrNoSecretVersionFound
+}
+
+func (ms *memoryStorage) PruneSecretVersions(_ context.Context, id string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if versions := ms.versions[id]; len(versions) > keep {
+		ms.versions[id] = append([]*pb.SecretVersion(nil), versions[len(versions)-keep:]...)
+	}
+
+	return nil
+}
+
+func (ms *memoryStorage) SaveSecretConflict(_ context.Context, remote *pb.Secret) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	stored := cloneSecret(remote)
+	stored.Status = &pb.Status{
+		Synced:  true,
+		Deleted: remote.GetStatus().GetDeleted(),
+	}
+
+	if conflict, ok := ms.conflicts[remote.GetID()]; ok {
+		conflict.Remote = stored
+		return nil
+	}
+
+	ms.conflicts[remote.GetID()] = &pb.SecretConflict{
+		Remote:     stored,
+		DetectedAt: timestamppb.Now(),
+	}
+	ms.conflictIDs = append(ms.conflictIDs, remote.GetID())
+
+	return nil
+}
+
+func (ms *memoryStorage) ListSecretConflicts(_ context.Context) ([]*pb.SecretConflict, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	var conflicts []*pb.SecretConflict
+	for _, id := range ms.conflictIDs {
+		conflicts = append(conflicts, proto.Clone(ms.conflicts[id]).(*pb.SecretConflict))
+	}
+
+	return conflicts, nil
+}
+
+func (ms *memoryStorage) GetSecretConflict(_ context.Context, id string) (*pb.SecretConflict, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	conflict, ok := ms.conflicts[id]
+	if !ok {
+		return nil, ErrNoSecretConflictFound
+	}
+
+	return proto.Clone(conflict).(*pb.SecretConflict), nil
+}
+
+func (ms *memoryStorage) DeleteSecretConflict(_ context.Context, id string) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.deleteSecretConflict(id)
+
+	return nil
+}
+
+func (ms *memoryStorage) deleteSecretConflict(id string) {
+	if _, ok := ms.conflicts[id]; ok {
+		delete(ms.conflicts, id)
+		ms.conflictIDs = removeID(ms.conflictIDs, id)
+	}
+}
+
+func (ms *memoryStorage) CreateAccount(_ context.Context, account *pb.Account) (string, error) {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.account != nil {
+		return "", ErrAccountExists
+	}
+
+	ms.account = &pb.Account{
+		ID:            account.GetID(),
+		ServerAddress: account.GetServerAddress(),
+		UserName:      account.GetUserName(),
+		UserPassword:  append([]byte(nil), account.GetUserPassword()...),
+	}
+	ms.syncCursor = 0
+
+	return account.GetID(), nil
+}
+
+func (ms *memoryStorage) GetAccount(_ context.Context) (*pb.Account, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	if ms.account == nil {
+		return nil, ErrAccountNotExists
+	}
+
+	return proto.Clone(ms.account).(*pb.Account), nil
+}
+
+func (ms *memoryStorage) UpdateAccount(_ context.Context, account *pb.Account) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.account == nil || ms.account.GetID() != account.GetID() {
+		return nil
+	}
+
+	if ms.account.GetServerAddress() != account.GetServerAddress() || ms.account.GetUserName() != account.GetUserName() {
+		ms.syncCursor = 0
+	}
+
+	ms.account = proto.Clone(account).(*pb.Account)
+
+	return nil
+}
+
+func (ms *memoryStorage) GetSyncCursor(_ context.Context) (uint64, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	if ms.account == nil {
+		return 0, ErrAccountNotExists
+	}
+
+	return ms.syncCursor, nil
+}
+
+func (ms *memoryStorage) UpdateSyncCursor(_ context.Context, cursor uint64) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.account != nil {
+		ms.syncCursor = cursor
+	}
+
+	return nil
+}
+
+func (ms *memoryStorage) DeleteAccount(_ context.Context) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	ms.account = nil
+	ms.syncCursor = 0
+
+	return nil
+}
+
+func (ms *memoryStorage) GetVault(_ context.Context) (*VaultInfo, error) {
+	ms.mu.RLock()
+	defer ms.mu.RUnlock()
+
+	if ms.vault == nil {
+		return nil, ErrNoVault
+	}
+
+	return cloneVault(ms.vault), nil
+}
+
+func (ms *memoryStorage) CreateVault(_ context.Context, vault *VaultInfo) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.vault != nil {
+		return ErrVaultExists
+	}
+
+	ms.vault = cloneVault(vault)
+
+	return nil
+}
+
+func (ms *memoryStorage) UpdateVault(_ context.Context, vault *VaultInfo) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	if ms.vault != nil {
+		ms.vault = cloneVault(vault)
+	}
+
+	return nil
+}
+
+// Rekey converts copies of secrets, versions and conflicts, the store is changed only if all of them are converted
+func (ms *memoryStorage) Rekey(_ context.Context, vault *VaultInfo, convert func([]byte) ([]byte, error)) error {
+	ms.mu.Lock()
+	defer ms.mu.Unlock()
+
+	secrets := make(map[string]*pb.Secret, len(ms.secrets))
+	for id, secret := range ms.secrets {
+		resealed := cloneSecret(secret)
+		if err := Reseal(resealed, convert); err != nil {
+			return err
+		}
+
+		secrets[id] = resealed
+	}
+
+	versions := make(map[string][]*pb.SecretVersion, len(ms.versions))
+	for id, archived := range ms.versions {
+		for _, version := range archived {
+			resealed := proto.Clone(version).(*pb.SecretVersion)
+			if err := Reseal(resealed.GetSecret(), convert); err != nil {
+				return err
+			}
+
+			versions[id] = append(versions[id], resealed)
+		}
+	}
+
+	conflicts := make(map[string]*pb.SecretConflict, len(ms.conflicts))
+	for id, conflict := range ms.conflicts {
+		resealed := proto.Clone(conflict).(*pb.SecretConflict)
+		if err := Reseal(resealed.GetRemote(), convert); err != nil {
+			return err
+		}
+
+		conflicts[id] = resealed
+	}
+
+	ms.secrets = secrets
+	ms.versions = versions
+	ms.conflicts = conflicts
+	ms.vault = cloneVault(vault)
+
+	return nil
+}
+
+func (ms *memoryStorage) Close() error {
+	return nil
+}
+
+// contentChanged reports whether data or payload of the secret differs from the stored one
+func contentChanged(stored, secret *pb.Secret) (bool, error) {
+	storedPayload, err := payload.Marshal(stored)
+	if err != nil {
+		return false, err
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return false, err
+	}
+
+	return !bytes.Equal(stored.GetData(), secret.GetData()) || !bytes.Equal(storedPayload, secretPayload), nil
+}
+
+func cloneSecret(secret *pb.Secret) *pb.Secret {
+	clone := proto.Clone(secret).(*pb.Secret)
+	if clone.Status == nil {
+		clone.Status = &pb.Status{}
+	}
+	if clone.Labels == nil {
+		clone.Labels = make(map[string]string, 0)
+	}
+
+	return clone
+}
+
+func cloneVault(vault *VaultInfo) *VaultInfo {
+	kdf := *vault.KDF
+	kdf.Salt = append([]byte(nil), vault.KDF.Salt...)
+
+	return &VaultInfo{
+		KDF:         &kdf,
+		Verifier:    append([]byte(nil), vault.Verifier...),
+		WrappedKey:  append([]byte(nil), vault.WrappedKey...),
+		RecoveryKey: append([]byte(nil), vault.RecoveryKey...),
+	}
+}
+
+func removeID(ids []string, id string) []string {
+	for i, existing := range ids {
+		if existing == id {
+			return append(ids[:i], ids[i+1:]...)
+		}
+	}
+
+	return ids
+}
@@ -5,14 +5,44 @@ import (
 	"errors"
 
 	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/selector"
 )
 
 var ErrNoSecretFound = errors.New("no secrets found with provided id")
 
+// SecretsQuery selects either deleted or actual secrets by labels, sorts and pages them
+type SecretsQuery struct {
+	Deleted  bool
+	Selector selector.Selector
+	// SortBy unspecified keeps the order of creation
+	SortBy     pb.SortBy
+	Descending bool
+	Offset     int
+	// Limit is the page size, 0 lists all secrets
+	Limit int
+}
+
 type Secrets interface {
 	CreateSecret(ctx context.Context, secret *pb.Secret) (string, error)
+	// CreateSecrets creates all secrets or none of them
+	CreateSecrets(ctx context.Context, secrets []*pb.Secret) ([]string, error)
+	// RestoreSecrets creates secrets as is along with their prior versions, deleted secrets are kept
+	// in the trash. All secrets are restored or none of them
+	RestoreSecrets(ctx context.Context, secrets []*pb.Secret, versions []*pb.SecretVersion) error
 	ListSecrets(ctx context.Context) ([]*pb.Secret, error)
+	// QuerySecrets returns the page of secrets matching the query and the number of all matching secrets
+	QuerySecrets(ctx context.Context, query SecretsQuery) ([]*pb.Secret, int, error)
+	// ListUnsyncedSecrets lists secrets changed locally since the last sync
+	ListUnsyncedSecrets(ctx context.Context) ([]*pb.Secret, error)
 	GetSecret(ctx context.Context, id string) (*pb.Secret, error)
 	UpdateSecret(ctx context.Context, secret *pb.Secret) error
+	// ReplaceSecret updates the secret without archiving the current content to the versions,
+	// e.g. to advance the HOTP counter which isn't a change of the user
+	ReplaceSecret(ctx context.Context, secret *pb.Secret) error
+	// MarkSecretSynced sets the server revision of the pushed secret,
+	// the secret is marked synced unless it was changed after being pushed
+	MarkSecretSynced(ctx context.Context, secret *pb.Secret, revision uint64) error
 	DeleteSecret(ctx context.Context, secret *pb.Secret) error
+	// PurgeSecret permanently removes the deleted secret
+	PurgeSecret(ctx context.Context, id string) error
 }
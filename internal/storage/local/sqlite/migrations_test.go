@@ -0,0 +1,63 @@
+package sqlite
+
+import (
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestRevisionMigration(t *testing.T) {
+	conn, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "vault.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { closeObject(conn) })
+
+	ss := &sqliteStorage{conn: conn}
+	migration, err := ss.newMigration()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The vault before revisions were introduced
+	if err := migration.Migrate(8); err != nil {
+		t.Fatal(err)
+	}
+
+	secrets := []struct {
+		id        string
+		updatedAt string
+		synced    bool
+		deleted   bool
+		revision  uint64
+	}{
+		{id: "synced", synced: true, revision: 1},
+		{id: "updated", updatedAt: "2020-09-13 12:26:40 +0000 UTC", revision: 1},
+		{id: "deleted", deleted: true, revision: 1},
+		{id: "created", revision: 0},
+	}
+	for _, secret := range secrets {
+		_, err := conn.Exec(`INSERT INTO secrets (id, labels, data, created_at, updated_at, synced, deleted)
+			VALUES (?, '{}', x'00', '2020-09-13 12:26:40 +0000 UTC', ?, ?, ?);`,
+			secret.id, secret.updatedAt, secret.synced, secret.deleted)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := ss.migrate(); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range secrets {
+		var revision uint64
+		if err := conn.QueryRow(`SELECT revision FROM secrets WHERE id=?;`, secret.id).Scan(&revision); err != nil {
+			t.Fatal(err)
+		}
+		if revision != secret.revision {
+			t.Errorf("revision of %s secret = %d, want %d", secret.id, revision, secret.revision)
+		}
+	}
+}
@@ -0,0 +1,1175 @@
+// Package sqlite implements the agent store kept in the SQLite database, the backend is registered on import
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/golang-migrate/migrate/v4"
+	"github.com/golang-migrate/migrate/v4/database/sqlite3"
+	bindata "github.com/golang-migrate/migrate/v4/source/go_bindata"
+	_ "github.com/mattn/go-sqlite3"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/go-rfe/gpwd/internal/agent/migrations"
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/logging/log"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+	"github.com/go-rfe/gpwd/internal/selector"
+	"github.com/go-rfe/gpwd/internal/storage/local"
+)
+
+const timestamppbDateFormat = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+var (
+	_ local.Storage        = (*sqliteStorage)(nil)
+	_ local.LegacyImporter = (*sqliteStorage)(nil)
+)
+
+type sqliteStorage struct {
+	conn *sql.DB
+}
+
+func init() {
+	local.Register(local.SQLiteBackend, func(path string) (local.Storage, error) {
+		return NewStorage(path)
+	})
+}
+
+func NewStorage(databasePath string) (*sqliteStorage, error) {
+	db, err := sql.Open("sqlite3", databasePath)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := &sqliteStorage{
+		conn: db,
+	}
+
+	if err := ss.migrate(); err != nil {
+		return nil, err
+	}
+
+	return ss, nil
+}
+
+// legacyDatabaseDSN returns DSN of the store created before the vault key derivation was introduced
+func legacyDatabaseDSN(databasePath string, masterPassword []byte) (string, error) {
+	currentUser, err := user.Current()
+	if err != nil {
+		return "", err
+	}
+
+	username := currentUser.Username
+
+	return fmt.Sprintf(
+		"%s?_auth&_auth_user=%s&_auth_pass=%s&_auth_crypt=SHA256",
+		databasePath, username, masterPassword,
+	), nil
+}
+
+func (ss *sqliteStorage) CreateSecret(ctx context.Context, secret *pb.Secret) (string, error) {
+	if err := insertSecret(ctx, ss.conn, secret); err != nil {
+		return "", err
+	}
+
+	return secret.ID, nil
+}
+
+// CreateSecrets creates all secrets in a single transaction
+func (ss *sqliteStorage) CreateSecrets(ctx context.Context, secrets []*pb.Secret) ([]string, error) {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer rollbackTx(tx)
+
+	ids := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		if err := insertSecret(ctx, tx, secret); err != nil {
+			return nil, err
+		}
+		ids = append(ids, secret.ID)
+	}
+
+	return ids, tx.Commit()
+}
+
+// RestoreSecrets restores secrets and their versions in a single transaction
+func (ss *sqliteStorage) RestoreSecrets(ctx context.Context, secrets []*pb.Secret, versions []*pb.SecretVersion) error {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(tx)
+
+	for _, secret := range secrets {
+		if err := insertSecret(ctx, tx, secret); err != nil {
+			return err
+		}
+
+		if !secret.GetStatus().GetDeleted() {
+			continue
+		}
+
+		deletedAt := secret.GetDeletedAt()
+		if deletedAt == nil {
+			deletedAt = timestamppb.Now()
+		}
+
+		_, err = tx.ExecContext(ctx, `
+			UPDATE secrets SET deleted=true, deleted_at=? WHERE id=?;
+		`, deletedAt.AsTime().String(), secret.GetID())
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, version := range versions {
+		if err := insertSecretVersion(ctx, tx, version); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func insertSecret(ctx context.Context, conn interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}, secret *pb.Secret) error {
+	var err error
+	var metadata []byte
+	if secret.Labels != nil {
+		metadata, err = json.Marshal(secret.Labels)
+		if err != nil {
+			return err
+		}
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	var updatedAt string
+	if secret.GetUpdatedAt() != nil {
+		updatedAt = secret.GetUpdatedAt().AsTime().String()
+	}
+
+	_, err = conn.ExecContext(ctx, `
+		INSERT INTO secrets (id, labels, created_at, updated_at, synced, data, payload, revision) VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+	`, secret.ID, metadata, secret.CreatedAt.AsTime().String(), updatedAt, secret.GetStatus().GetSynced(),
+		secret.GetData(), secretPayload, secret.GetRevision())
+
+	return err
+}
+
+func (ss *sqliteStorage) ListSecrets(ctx context.Context) ([]*pb.Secret, error) {
+	return ss.listSecrets(ctx, `
+		SELECT id, labels, 
+		created_at, updated_at, deleted_at,
+		synced, deleted,
+		data, payload, revision
+		FROM secrets;
+	`)
+}
+
+func (ss *sqliteStorage) ListUnsyncedSecrets(ctx context.Context) ([]*pb.Secret, error) {
+	return ss.listSecrets(ctx, `
+		SELECT id, labels, 
+		created_at, updated_at, deleted_at,
+		synced, deleted,
+		data, payload, revision
+		FROM secrets WHERE synced=false;
+	`)
+}
+
+// QuerySecrets evaluates the selector with SQLite JSON functions on labels, sorts and pages secrets in SQL
+func (ss *sqliteStorage) QuerySecrets(ctx context.Context, query local.SecretsQuery) ([]*pb.Secret, int, error) {
+	where, args := secretsQueryWhere(query)
+
+	var total int
+	row := ss.conn.QueryRowContext(ctx, `SELECT COUNT(*) FROM secrets WHERE `+where+`;`, args...)
+	if err := row.Scan(&total); err != nil {
+		return nil, 0, err
+	}
+
+	// The negative limit lists all secrets
+	limit := -1
+	if query.Limit > 0 {
+		limit = query.Limit
+	}
+
+	secrets, err := ss.listSecrets(ctx, `
+		SELECT id, labels,
+		created_at, updated_at, deleted_at,
+		synced, deleted,
+		data, payload, revision
+		FROM secrets WHERE `+where+`
+		ORDER BY `+secretsQueryOrder(query)+`
+		LIMIT ? OFFSET ?;
+	`, append(args, limit, query.Offset)...)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return secrets, total, nil
+}
+
+// secretsQueryWhere returns the condition of the query, labels are kept as JSON BLOB,
+// so they are cast to text for JSON functions
+func secretsQueryWhere(query local.SecretsQuery) (string, []interface{}) {
+	conditions := []string{"deleted=?"}
+	args := []interface{}{query.Deleted}
+
+	for _, requirement := range query.Selector {
+		// Selector keys have no quotes, so the quoted key is a valid JSON path
+		path := `$."` + requirement.Key + `"`
+		value := `json_extract(CAST(labels AS TEXT), ?)`
+		values := strings.TrimSuffix(strings.Repeat("?, ", len(requirement.Values)), ", ")
+
+		switch requirement.Operator {
+		case selector.Equals:
+			conditions = append(conditions, value+` = ?`)
+		case selector.NotEquals:
+			conditions = append(conditions, value+` IS NOT ?`)
+		case selector.In:
+			conditions = append(conditions, value+` IN (`+values+`)`)
+		case selector.NotIn:
+			conditions = append(conditions, `NOT COALESCE(`+value+` IN (`+values+`), false)`)
+		case selector.Exists:
+			conditions = append(conditions, `json_type(CAST(labels AS TEXT), ?) IS NOT NULL`)
+		case selector.DoesNotExist:
+			conditions = append(conditions, `json_type(CAST(labels AS TEXT), ?) IS NULL`)
+		default:
+			conditions = append(conditions, `false`)
+		}
+
+		args = append(args, path)
+		for _, v := range requirement.Values {
+			args = append(args, v)
+		}
+	}
+
+	return strings.Join(conditions, " AND "), args
+}
+
+// secretsQueryOrder returns the order of the query, timestamps are stored in UTC
+// with trailing zeros of fractions trimmed, so their text order is chronological
+func secretsQueryOrder(query local.SecretsQuery) string {
+	direction := "ASC"
+	if query.Descending {
+		direction = "DESC"
+	}
+
+	switch query.SortBy {
+	case pb.SortBy_SORT_CREATED_AT:
+		return "created_at " + direction + ", rowid " + direction
+	case pb.SortBy_SORT_UPDATED_AT:
+		return "COALESCE(NULLIF(updated_at, ''), created_at) " + direction + ", rowid " + direction
+	default:
+		return "rowid " + direction
+	}
+}
+
+func (ss *sqliteStorage) listSecrets(ctx context.Context, query string, args ...interface{}) ([]*pb.Secret, error) {
+	var secrets []*pb.Secret
+	rows, err := ss.conn.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		var createdAtString, updatedAtString, deletedAtString sql.NullString
+		secret := &pb.Secret{
+			ID:     "",
+			Labels: make(map[string]string, 0),
+			Data:   make([]byte, 0),
+			Status: &pb.Status{},
+		}
+
+		labels := make([]byte, 0)
+		var secretPayload []byte
+
+		err = rows.Scan(
+			&secret.ID, &labels,
+			&createdAtString, &updatedAtString, &deletedAtString,
+			&secret.Status.Synced, &secret.Status.Deleted,
+			&secret.Data, &secretPayload, &secret.Revision)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := payload.Unmarshal(secretPayload, secret); err != nil {
+			return nil, err
+		}
+
+		if len(labels) > 0 {
+			if err := json.Unmarshal(labels, &secret.Labels); err != nil {
+				return nil, err
+			}
+		}
+
+		if createdAtString.String != "" {
+			createdAt, err := time.Parse(timestamppbDateFormat, createdAtString.String)
+			if err != nil {
+				return nil, err
+			}
+			secret.CreatedAt = timestamppb.New(createdAt)
+		}
+
+		if updatedAtString.String != "" {
+			updatedAt, err := time.Parse(timestamppbDateFormat, updatedAtString.String)
+			if err != nil {
+				return nil, err
+			}
+			secret.UpdatedAt = timestamppb.New(updatedAt)
+		}
+
+		if deletedAtString.String != "" {
+			deletedAt, err := time.Parse(timestamppbDateFormat, deletedAtString.String)
+			if err != nil {
+				return nil, err
+			}
+			secret.DeletedAt = timestamppb.New(deletedAt)
+		}
+
+		secrets = append(secrets, secret)
+	}
+
+	return secrets, rows.Err()
+}
+
+func (ss *sqliteStorage) GetSecret(ctx context.Context, id string) (*pb.Secret, error) {
+	secret := &pb.Secret{
+		Labels: make(map[string]string, 0),
+		Data:   make([]byte, 0),
+		Status: &pb.Status{},
+	}
+
+	labels := make([]byte, 0)
+	var secretPayload []byte
+
+	var createdAtString, updatedAtString, deletedAtString sql.NullString
+	row := ss.conn.QueryRowContext(ctx, `
+		SELECT id, labels, created_at, updated_at, deleted_at, synced, deleted, data, payload, revision FROM secrets WHERE id=?;
+	`, id)
+
+	err := row.Scan(
+		&secret.ID, &labels,
+		&createdAtString, &updatedAtString, &deletedAtString,
+		&secret.Status.Synced, &secret.Status.Deleted,
+		&secret.Data, &secretPayload, &secret.Revision,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, local.ErrNoSecretFound
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := payload.Unmarshal(secretPayload, secret); err != nil {
+		return nil, err
+	}
+
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &secret.Labels); err != nil {
+			return nil, err
+		}
+	}
+
+	if createdAtString.String != "" {
+		createdAt, err := time.Parse(timestamppbDateFormat, createdAtString.String)
+		if err != nil {
+			return nil, err
+		}
+		secret.CreatedAt = timestamppb.New(createdAt)
+	}
+
+	if updatedAtString.String != "" {
+		updatedAt, err := time.Parse(timestamppbDateFormat, updatedAtString.String)
+		if err != nil {
+			return nil, err
+		}
+		secret.UpdatedAt = timestamppb.New(updatedAt)
+	}
+
+	if deletedAtString.String != "" {
+		deletedAt, err := time.Parse(timestamppbDateFormat, deletedAtString.String)
+		if err != nil {
+			return nil, err
+		}
+		secret.DeletedAt = timestamppb.New(deletedAt)
+	}
+
+	return secret, nil
+}
+
+// UpdateSecret archives the current revision to secret_versions if data or payload changes,
+// the deleted status is taken from the secret, so updating a deleted secret restores it
+func (ss *sqliteStorage) UpdateSecret(ctx context.Context, secret *pb.Secret) error {
+	return ss.updateSecret(ctx, secret, true)
+}
+
+func (ss *sqliteStorage) ReplaceSecret(ctx context.Context, secret *pb.Secret) error {
+	return ss.updateSecret(ctx, secret, false)
+}
+
+func (ss *sqliteStorage) updateSecret(ctx context.Context, secret *pb.Secret, archive bool) error {
+	metadata, err := json.Marshal(secret.GetLabels())
+	if err != nil {
+		return err
+	}
+
+	var updatedAt string
+	if secret.GetUpdatedAt() != nil {
+		updatedAt = secret.GetUpdatedAt().AsTime().String()
+	}
+
+	var deletedAt sql.NullString
+	if secret.GetDeletedAt() != nil {
+		deletedAt = sql.NullString{String: secret.GetDeletedAt().AsTime().String(), Valid: true}
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(tx)
+
+	if archive {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO secret_versions (secret_id, version, labels, created_at, updated_at, data, payload, archived_at)
+			SELECT id, COALESCE((SELECT MAX(version) FROM secret_versions WHERE secret_id=?), 0) + 1,
+			labels, created_at, updated_at, data, payload, ?
+			FROM secrets
+			WHERE id=? AND deleted=false AND (data IS NOT ? OR payload IS NOT ?);
+		`, secret.GetID(), timestamppb.Now().AsTime().String(), secret.GetID(), secret.GetData(), secretPayload)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE secrets SET labels=?, updated_at=?, deleted_at=?, synced=?, deleted=?, data=?, payload=?, revision=? WHERE id=?;
+	`, metadata, updatedAt, deletedAt, secret.GetStatus().GetSynced(), secret.GetStatus().GetDeleted(),
+		secret.GetData(), secretPayload, secret.GetRevision(), secret.GetID())
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// MarkSecretSynced compares the secret with the stored one to mark it synced only if it's unchanged
+func (ss *sqliteStorage) MarkSecretSynced(ctx context.Context, secret *pb.Secret, revision uint64) error {
+	var updatedAt string
+	if secret.GetUpdatedAt() != nil {
+		updatedAt = secret.GetUpdatedAt().AsTime().String()
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	_, err = ss.conn.ExecContext(ctx, `
+		UPDATE secrets SET
+		revision=?,
+		synced=(updated_at IS ? AND deleted=? AND data IS ? AND payload IS ?)
+		WHERE id=?;
+	`, revision, updatedAt, secret.GetStatus().GetDeleted(), secret.GetData(), secretPayload, secret.GetID())
+
+	return err
+}
+
+// DeleteSecret moves the secret to the trash, the content is kept until the secret is purged
+func (ss *sqliteStorage) DeleteSecret(ctx context.Context, secret *pb.Secret) error {
+	deletedAt := secret.GetDeletedAt()
+	if deletedAt == nil {
+		deletedAt = timestamppb.Now()
+	}
+
+	_, err := ss.conn.ExecContext(ctx, `
+		UPDATE secrets SET
+		deleted_at=?, 
+		synced=?, 
+		deleted=true 
+		WHERE id=?;
+	`, deletedAt.AsTime().String(), secret.Status.GetSynced(), secret.GetID())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// PurgeSecret permanently removes the secret with its history
+func (ss *sqliteStorage) PurgeSecret(ctx context.Context, id string) error {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(tx)
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM secret_versions WHERE secret_id=?;`, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM secret_conflicts WHERE secret_id=?;`, id); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM secrets WHERE id=?;`, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func insertSecretVersion(ctx context.Context, tx *sql.Tx, version *pb.SecretVersion) error {
+	secret := version.GetSecret()
+
+	metadata, err := json.Marshal(secret.GetLabels())
+	if err != nil {
+		return err
+	}
+
+	secretPayload, err := payload.Marshal(secret)
+	if err != nil {
+		return err
+	}
+
+	var updatedAt string
+	if secret.GetUpdatedAt() != nil {
+		updatedAt = secret.GetUpdatedAt().AsTime().String()
+	}
+
+	archivedAt := version.GetArchivedAt()
+	if archivedAt == nil {
+		archivedAt = timestamppb.Now()
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		INSERT INTO secret_versions (secret_id, version, labels, created_at, updated_at, data, payload, archived_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+	`, secret.GetID(), version.GetVersion(), metadata, secret.GetCreatedAt().AsTime().String(), updatedAt,
+		secret.GetData(), secretPayload, archivedAt.AsTime().String())
+
+	return err
+}
+
+func (ss *sqliteStorage) ListSecretVersions(ctx context.Context, id string) ([]*pb.SecretVersion, error) {
+	var versions []*pb.SecretVersion
+	rows, err := ss.conn.QueryContext(ctx, `
+		SELECT secret_id, version, labels, created_at, updated_at, data, payload, archived_at
+		FROM secret_versions WHERE secret_id=? ORDER BY version DESC;
+	`, id)
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		version, err := scanSecretVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, rows.Err()
+}
+
+func (ss *sqliteStorage) GetSecretVersion(ctx context.Context, id string, version uint64) (*pb.SecretVersion, error) {
+	row := ss.conn.QueryRowContext(ctx, `
+		SELECT secret_id, version, labels, created_at, updated_at, data, payload, archived_at
+		FROM secret_versions WHERE secret_id=? AND version=?;
+	`, id, version)
+
+	secretVersion, err := scanSecretVersion(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, local.ErrNoSecretVersionFound
+	}
+
+	return secretVersion, err
+}
+
+func (ss *sqliteStorage) PruneSecretVersions(ctx context.Context, id string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	_, err := ss.conn.ExecContext(ctx, `
+		DELETE FROM secret_versions WHERE secret_id=? AND version <= (
+			SELECT MAX(version) FROM secret_versions WHERE secret_id=?
+		) - ?;
+	`, id, id, keep)
+
+	return err
+}
+
+func (ss *sqliteStorage) SaveSecretConflict(ctx context.Context, remote *pb.Secret) error {
+	metadata, err := json.Marshal(remote.GetLabels())
+	if err != nil {
+		return err
+	}
+
+	secretPayload, err := payload.Marshal(remote)
+	if err != nil {
+		return err
+	}
+
+	var createdAt, updatedAt, deletedAt sql.NullString
+	if remote.GetCreatedAt() != nil {
+		createdAt = sql.NullString{String: remote.GetCreatedAt().AsTime().String(), Valid: true}
+	}
+	if remote.GetUpdatedAt() != nil {
+		updatedAt = sql.NullString{String: remote.GetUpdatedAt().AsTime().String(), Valid: true}
+	}
+	if remote.GetDeletedAt() != nil {
+		deletedAt = sql.NullString{String: remote.GetDeletedAt().AsTime().String(), Valid: true}
+	}
+
+	_, err = ss.conn.ExecContext(ctx, `
+		INSERT INTO secret_conflicts
+		(secret_id, labels, created_at, updated_at, deleted_at, deleted, data, payload, revision, detected_at)
+		VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+		ON CONFLICT(secret_id) DO UPDATE SET
+		labels=excluded.labels,
+		created_at=excluded.created_at,
+		updated_at=excluded.updated_at,
+		deleted_at=excluded.deleted_at,
+		deleted=excluded.deleted,
+		data=excluded.data,
+		payload=excluded.payload,
+		revision=excluded.revision;
+	`, remote.GetID(), metadata, createdAt, updatedAt, deletedAt, remote.GetStatus().GetDeleted(),
+		remote.GetData(), secretPayload, remote.GetRevision(), timestamppb.Now().AsTime().String())
+
+	return err
+}
+
+func (ss *sqliteStorage) ListSecretConflicts(ctx context.Context) ([]*pb.SecretConflict, error) {
+	var conflicts []*pb.SecretConflict
+	rows, err := ss.conn.QueryContext(ctx, `
+		SELECT secret_id, labels, created_at, updated_at, deleted_at, deleted, data, payload, revision, detected_at
+		FROM secret_conflicts;
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		conflict, err := scanSecretConflict(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		conflicts = append(conflicts, conflict)
+	}
+
+	return conflicts, rows.Err()
+}
+
+func (ss *sqliteStorage) GetSecretConflict(ctx context.Context, id string) (*pb.SecretConflict, error) {
+	row := ss.conn.QueryRowContext(ctx, `
+		SELECT secret_id, labels, created_at, updated_at, deleted_at, deleted, data, payload, revision, detected_at
+		FROM secret_conflicts WHERE secret_id=?;
+	`, id)
+
+	conflict, err := scanSecretConflict(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, local.ErrNoSecretConflictFound
+	}
+
+	return conflict, err
+}
+
+func (ss *sqliteStorage) DeleteSecretConflict(ctx context.Context, id string) error {
+	_, err := ss.conn.ExecContext(ctx, `DELETE FROM secret_conflicts WHERE secret_id=?;`, id)
+
+	return err
+}
+
+// scanSecretConflict scans the remote side of the conflict
+func scanSecretConflict(row interface {
+	Scan(dest ...interface{}) error
+}) (*pb.SecretConflict, error) {
+	remote := &pb.Secret{
+		Labels: make(map[string]string, 0),
+		Status: &pb.Status{Synced: true},
+	}
+	conflict := &pb.SecretConflict{
+		Remote: remote,
+	}
+
+	var labels, secretPayload []byte
+	var createdAtString, updatedAtString, deletedAtString, detectedAtString sql.NullString
+
+	err := row.Scan(
+		&remote.ID, &labels,
+		&createdAtString, &updatedAtString, &deletedAtString, &remote.Status.Deleted,
+		&remote.Data, &secretPayload, &remote.Revision, &detectedAtString,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &remote.Labels); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := payload.Unmarshal(secretPayload, remote); err != nil {
+		return nil, err
+	}
+
+	if remote.CreatedAt, err = parseTimestamp(createdAtString); err != nil {
+		return nil, err
+	}
+
+	if remote.UpdatedAt, err = parseTimestamp(updatedAtString); err != nil {
+		return nil, err
+	}
+
+	if remote.DeletedAt, err = parseTimestamp(deletedAtString); err != nil {
+		return nil, err
+	}
+
+	if conflict.DetectedAt, err = parseTimestamp(detectedAtString); err != nil {
+		return nil, err
+	}
+
+	return conflict, nil
+}
+
+func scanSecretVersion(row interface {
+	Scan(dest ...interface{}) error
+}) (*pb.SecretVersion, error) {
+	secret := &pb.Secret{
+		Labels: make(map[string]string, 0),
+		Status: &pb.Status{},
+	}
+	secretVersion := &pb.SecretVersion{
+		Secret: secret,
+	}
+
+	var labels, secretPayload []byte
+	var createdAtString, updatedAtString, archivedAtString sql.NullString
+
+	err := row.Scan(
+		&secret.ID, &secretVersion.Version, &labels,
+		&createdAtString, &updatedAtString,
+		&secret.Data, &secretPayload, &archivedAtString,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(labels) > 0 {
+		if err := json.Unmarshal(labels, &secret.Labels); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := payload.Unmarshal(secretPayload, secret); err != nil {
+		return nil, err
+	}
+
+	if secret.CreatedAt, err = parseTimestamp(createdAtString); err != nil {
+		return nil, err
+	}
+
+	if secret.UpdatedAt, err = parseTimestamp(updatedAtString); err != nil {
+		return nil, err
+	}
+
+	if secretVersion.ArchivedAt, err = parseTimestamp(archivedAtString); err != nil {
+		return nil, err
+	}
+
+	return secretVersion, nil
+}
+
+// parseTimestamp parses time stored as timestamppb string, empty value gives nil
+func parseTimestamp(value sql.NullString) (*timestamppb.Timestamp, error) {
+	if value.String == "" {
+		return nil, nil
+	}
+
+	t, err := time.Parse(timestamppbDateFormat, value.String)
+	if err != nil {
+		return nil, err
+	}
+
+	return timestamppb.New(t), nil
+}
+
+func (ss *sqliteStorage) CreateAccount(ctx context.Context, account *pb.Account) (string, error) {
+	_, err := ss.conn.ExecContext(ctx, `
+		INSERT INTO accounts (id, server, username, password) VALUES (?, ?, ?, ?);
+	`, account.GetID(), account.GetServerAddress(), account.GetUserName(), account.GetUserPassword())
+	if err != nil {
+		return "", err
+	}
+
+	return account.ID, nil
+}
+
+func (ss *sqliteStorage) GetAccount(ctx context.Context) (*pb.Account, error) {
+	account := &pb.Account{
+		UserPassword: make([]byte, 0),
+	}
+
+	row := ss.conn.QueryRowContext(ctx, `SELECT id, server, username, password, registered FROM accounts LIMIT 1;`)
+
+	err := row.Scan(&account.ID, &account.ServerAddress, &account.UserName, &account.UserPassword, &account.Registered)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, local.ErrAccountNotExists
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return account, nil
+}
+
+func (ss *sqliteStorage) UpdateAccount(ctx context.Context, account *pb.Account) error {
+	_, err := ss.conn.ExecContext(ctx, `
+		UPDATE accounts SET
+		sync_cursor=CASE WHEN server=? AND username=? THEN sync_cursor ELSE 0 END,
+		server=?, username=?, password=?, registered=? WHERE id=?;
+	`, account.GetServerAddress(), account.GetUserName(),
+		account.GetServerAddress(), account.GetUserName(), account.GetUserPassword(), account.GetRegistered(), account.GetID())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ss *sqliteStorage) GetSyncCursor(ctx context.Context) (uint64, error) {
+	var cursor uint64
+	err := ss.conn.QueryRowContext(ctx, `SELECT sync_cursor FROM accounts LIMIT 1;`).Scan(&cursor)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, local.ErrAccountNotExists
+	}
+
+	return cursor, err
+}
+
+func (ss *sqliteStorage) UpdateSyncCursor(ctx context.Context, cursor uint64) error {
+	_, err := ss.conn.ExecContext(ctx, `UPDATE accounts SET sync_cursor=?;`, cursor)
+
+	return err
+}
+
+func (ss *sqliteStorage) DeleteAccount(ctx context.Context) error {
+	_, err := ss.conn.ExecContext(ctx, `DELETE FROM accounts;`)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ss *sqliteStorage) GetVault(ctx context.Context) (*local.VaultInfo, error) {
+	vault := &local.VaultInfo{
+		KDF: &encryption.KDFParams{},
+	}
+
+	row := ss.conn.QueryRowContext(ctx, `
+		SELECT kdf, salt, time, memory, threads, verifier, wrapped_key, recovery_key FROM vault WHERE id=1;
+	`)
+
+	err := row.Scan(
+		&vault.KDF.Algorithm, &vault.KDF.Salt,
+		&vault.KDF.Time, &vault.KDF.Memory, &vault.KDF.Threads,
+		&vault.Verifier, &vault.WrappedKey, &vault.RecoveryKey,
+	)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, local.ErrNoVault
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return vault, nil
+}
+
+func (ss *sqliteStorage) CreateVault(ctx context.Context, vault *local.VaultInfo) error {
+	_, err := ss.conn.ExecContext(ctx, `
+		INSERT INTO vault (id, kdf, salt, time, memory, threads, verifier, wrapped_key, recovery_key)
+		VALUES (1, ?, ?, ?, ?, ?, ?, ?, ?);
+	`, vault.KDF.Algorithm, vault.KDF.Salt,
+		vault.KDF.Time, vault.KDF.Memory, vault.KDF.Threads,
+		vault.Verifier, vault.WrappedKey, vault.RecoveryKey)
+
+	return err
+}
+
+func (ss *sqliteStorage) UpdateVault(ctx context.Context, vault *local.VaultInfo) error {
+	_, err := ss.conn.ExecContext(ctx, `
+		UPDATE vault SET kdf=?, salt=?, time=?, memory=?, threads=?, verifier=?, wrapped_key=?, recovery_key=? WHERE id=1;
+	`, vault.KDF.Algorithm, vault.KDF.Salt,
+		vault.KDF.Time, vault.KDF.Memory, vault.KDF.Threads,
+		vault.Verifier, vault.WrappedKey, vault.RecoveryKey)
+
+	return err
+}
+
+// ImportLegacy copies secrets and account from the legacy store, re-encrypting sensitive data with convert.
+// The legacy store itself is left untouched.
+func (ss *sqliteStorage) ImportLegacy(
+	ctx context.Context,
+	legacyPath string,
+	masterPassword []byte,
+	convert func([]byte) ([]byte, error),
+) error {
+	dsn, err := legacyDatabaseDSN(legacyPath, masterPassword)
+	if err != nil {
+		return err
+	}
+
+	legacy, err := sql.Open("sqlite3", dsn)
+	if err != nil {
+		return err
+	}
+	defer closeObject(legacy)
+
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(tx)
+
+	if err := importLegacySecrets(ctx, legacy, tx, convert); err != nil {
+		return err
+	}
+
+	if err := importLegacyAccounts(ctx, legacy, tx, convert); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func importLegacySecrets(ctx context.Context, legacy *sql.DB, tx *sql.Tx, convert func([]byte) ([]byte, error)) error {
+	rows, err := legacy.QueryContext(ctx, `
+		SELECT id, labels, 
+		created_at, updated_at, deleted_at,
+		synced, deleted,
+		data 
+		FROM secrets;
+	`)
+	if err != nil {
+		return err
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		var id string
+		var labels, createdAt, updatedAt, deletedAt sql.NullString
+		var synced, deleted bool
+		var data []byte
+
+		if err := rows.Scan(&id, &labels, &createdAt, &updatedAt, &deletedAt, &synced, &deleted, &data); err != nil {
+			return err
+		}
+
+		if data != nil {
+			data, err = convert(data)
+			if err != nil {
+				return fmt.Errorf("couldn't convert secret %s: %w", id, err)
+			}
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO secrets (id, labels, created_at, updated_at, deleted_at, synced, deleted, data)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?);
+		`, id, labels, createdAt, updatedAt, deletedAt, synced, deleted, data); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+func importLegacyAccounts(ctx context.Context, legacy *sql.DB, tx *sql.Tx, convert func([]byte) ([]byte, error)) error {
+	rows, err := legacy.QueryContext(ctx, `SELECT id, server, username, password, registered FROM accounts;`)
+	if err != nil {
+		return err
+	}
+	defer closeRows(rows)
+
+	for rows.Next() {
+		account := &pb.Account{}
+
+		err := rows.Scan(&account.ID, &account.ServerAddress, &account.UserName, &account.UserPassword, &account.Registered)
+		if err != nil {
+			return err
+		}
+
+		account.UserPassword, err = convert(account.GetUserPassword())
+		if err != nil {
+			return fmt.Errorf("couldn't convert account %s: %w", account.GetID(), err)
+		}
+
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO accounts (id, server, username, password, registered) VALUES (?, ?, ?, ?, ?);
+		`, account.GetID(), account.GetServerAddress(), account.GetUserName(), account.GetUserPassword(), account.GetRegistered()); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
+// Rekey converts sealed data of secrets, versions and conflicts and replaces the vault in a single transaction
+func (ss *sqliteStorage) Rekey(ctx context.Context, vault *local.VaultInfo, convert func([]byte) ([]byte, error)) error {
+	tx, err := ss.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer rollbackTx(tx)
+
+	for _, table := range []string{"secrets", "secret_versions", "secret_conflicts"} {
+		if err := rekeyTable(ctx, tx, table, convert); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, `
+		UPDATE vault SET kdf=?, salt=?, time=?, memory=?, threads=?, verifier=?, wrapped_key=?, recovery_key=? WHERE id=1;
+	`, vault.KDF.Algorithm, vault.KDF.Salt,
+		vault.KDF.Time, vault.KDF.Memory, vault.KDF.Threads,
+		vault.Verifier, vault.WrappedKey, vault.RecoveryKey)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// rekeyTable converts data and payload of every row of the table, rows are read before any of them is updated
+func rekeyTable(ctx context.Context, tx *sql.Tx, table string, convert func([]byte) ([]byte, error)) error {
+	type sealedRow struct {
+		rowID  int64
+		secret *pb.Secret
+	}
+
+	rows, err := tx.QueryContext(ctx, fmt.Sprintf(`SELECT rowid, data, payload FROM %s;`, table))
+	if err != nil {
+		return err
+	}
+	defer closeRows(rows)
+
+	var sealed []sealedRow
+	for rows.Next() {
+		var secretPayload []byte
+		row := sealedRow{secret: &pb.Secret{}}
+
+		if err := rows.Scan(&row.rowID, &row.secret.Data, &secretPayload); err != nil {
+			return err
+		}
+
+		if err := payload.Unmarshal(secretPayload, row.secret); err != nil {
+			return err
+		}
+
+		sealed = append(sealed, row)
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for _, row := range sealed {
+		if err := local.Reseal(row.secret, convert); err != nil {
+			return fmt.Errorf("couldn't convert %s row %d: %w", table, row.rowID, err)
+		}
+
+		secretPayload, err := payload.Marshal(row.secret)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.ExecContext(ctx, fmt.Sprintf(`UPDATE %s SET data=?, payload=? WHERE rowid=?;`, table),
+			row.secret.GetData(), secretPayload, row.rowID)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (ss *sqliteStorage) Close() error {
+	return ss.conn.Close()
+}
+
+func (ss *sqliteStorage) migrate() error {
+	migration, err := ss.newMigration()
+	if err != nil {
+		return err
+	}
+
+	if err := migration.Up(); !errors.Is(err, migrate.ErrNoChange) {
+		return err
+	}
+
+	return nil
+}
+
+func (ss *sqliteStorage) newMigration() (*migrate.Migrate, error) {
+	data := bindata.Resource(migrations.AssetNames(), migrations.Asset)
+
+	sourceDriver, err := bindata.WithInstance(data)
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := sqlite3.WithInstance(ss.conn, &sqlite3.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	return migrate.NewWithInstance("go-bindata", sourceDriver, "sqlite3", db)
+}
+
+func closeRows(rows *sql.Rows) {
+	err := rows.Close()
+	if err != nil {
+		log.Error().Err(err).Msgf("Couldn't close rows")
+	}
+}
+
+func closeObject(closer io.Closer) {
+	if err := closer.Close(); err != nil {
+		log.Error().Err(err).Msg("Failed to close object")
+	}
+}
+
+func rollbackTx(tx *sql.Tx) {
+	if err := tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		log.Error().Err(err).Msg("Failed to rollback transaction")
+	}
+}
@@ -0,0 +1,21 @@
+package sqlite_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-rfe/gpwd/internal/storage/local"
+	"github.com/go-rfe/gpwd/internal/storage/local/localtest"
+	"github.com/go-rfe/gpwd/internal/storage/local/sqlite"
+)
+
+func TestStorage(t *testing.T) {
+	localtest.Run(t, func(t *testing.T) local.Storage {
+		storage, err := sqlite.NewStorage(filepath.Join(t.TempDir(), "vault.db"))
+		if err != nil {
+			t.Fatalf("NewStorage() error = %v", err)
+		}
+
+		return storage
+	})
+}
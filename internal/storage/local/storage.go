@@ -0,0 +1,78 @@
+package local
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+)
+
+// Storage is the agent store, every backend keeps secrets, account and vault together
+type Storage interface {
+	Secrets
+	Accounts
+	Vault
+	SecretVersions
+	SecretConflicts
+	io.Closer
+}
+
+// SQLiteBackend is the default backend registered by the sqlite package
+const SQLiteBackend = "sqlite"
+
+// LegacyImporter is implemented by backends able to upgrade the store created before the vault key derivation
+type LegacyImporter interface {
+	// ImportLegacy copies secrets and account from the legacy store, re-encrypting sensitive data with convert
+	ImportLegacy(ctx context.Context, legacyPath string, masterPassword []byte, convert func([]byte) ([]byte, error)) error
+}
+
+// Opener opens the store of the backend at the path, backends not kept on disk ignore the path
+type Opener func(path string) (Storage, error)
+
+var (
+	backendsMu sync.RWMutex
+	backends   = make(map[string]Opener)
+)
+
+// Register makes the storage backend available by the name, it panics if the name is already taken
+func Register(name string, opener Opener) {
+	backendsMu.Lock()
+	defer backendsMu.Unlock()
+
+	if opener == nil {
+		panic("local: Register opener is nil")
+	}
+	if _, ok := backends[name]; ok {
+		panic("local: Register called twice for backend " + name)
+	}
+
+	backends[name] = opener
+}
+
+// Backends returns sorted names of registered storage backends
+func Backends() []string {
+	backendsMu.RLock()
+	defer backendsMu.RUnlock()
+
+	names := make([]string, 0, len(backends))
+	for name := range backends {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// Open opens the store with the registered backend
+func Open(backend, path string) (Storage, error) {
+	backendsMu.RLock()
+	opener, ok := backends[backend]
+	backendsMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown storage backend %q, available backends: %v", backend, Backends())
+	}
+
+	return opener(path)
+}
@@ -0,0 +1,26 @@
+package local_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/go-rfe/gpwd/internal/storage/local"
+	"github.com/go-rfe/gpwd/internal/storage/local/localtest"
+)
+
+// Every registered backend must pass the conformance suite
+func TestBackends(t *testing.T) {
+	for _, backend := range local.Backends() {
+		backend := backend
+		t.Run(backend, func(t *testing.T) {
+			localtest.Run(t, func(t *testing.T) local.Storage {
+				storage, err := local.Open(backend, filepath.Join(t.TempDir(), "vault.db"))
+				if err != nil {
+					t.Fatalf("Open(%s) error = %v", backend, err)
+				}
+
+				return storage
+			})
+		})
+	}
+}
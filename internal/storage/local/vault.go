@@ -0,0 +1,46 @@
+package local
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-rfe/gpwd/internal/encryption"
+	"github.com/go-rfe/gpwd/internal/payload"
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var ErrNoVault = errors.New("vault is not initialized")
+
+// VaultInfo holds key derivation settings and wrapped keys persisted alongside the store
+type VaultInfo struct {
+	KDF *encryption.KDFParams
+	// Verifier is a known value sealed with the derived key, used to detect a wrong master password
+	Verifier []byte
+	// WrappedKey is the data encryption key sealed with the master password derived key
+	WrappedKey []byte
+	// RecoveryKey is the data encryption key sealed with the recovery key
+	RecoveryKey []byte
+}
+
+type Vault interface {
+	GetVault(ctx context.Context) (*VaultInfo, error)
+	CreateVault(ctx context.Context, vault *VaultInfo) error
+	UpdateVault(ctx context.Context, vault *VaultInfo) error
+	// Rekey converts sealed data of secrets, their versions and conflicts and replaces the vault,
+	// all at once or nothing. It's used to adopt the data encryption key of another vault
+	Rekey(ctx context.Context, vault *VaultInfo, convert func([]byte) ([]byte, error)) error
+}
+
+// Reseal converts data and all sensitive payload fields of the sealed secret in place
+func Reseal(secret *pb.Secret, convert func([]byte) ([]byte, error)) error {
+	if len(secret.GetData()) > 0 {
+		data, err := convert(secret.GetData())
+		if err != nil {
+			return err
+		}
+
+		secret.Data = data
+	}
+
+	return payload.Seal(secret, convert)
+}
@@ -0,0 +1,18 @@
+package local
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/go-rfe/gpwd/internal/proto"
+)
+
+var ErrNoSecretVersionFound = errors.New("no secret version found with provided id and version")
+
+// SecretVersions keeps prior revisions of secrets archived on every update of the secret content
+type SecretVersions interface {
+	ListSecretVersions(ctx context.Context, id string) ([]*pb.SecretVersion, error)
+	GetSecretVersion(ctx context.Context, id string, version uint64) (*pb.SecretVersion, error)
+	// PruneSecretVersions keeps only the latest revisions of the secret, keep <= 0 keeps all of them
+	PruneSecretVersions(ctx context.Context, id string, keep int) error
+}
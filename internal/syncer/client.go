@@ -3,37 +3,49 @@ package syncer
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	grpc_retry "github.com/grpc-ecosystem/go-grpc-middleware/retry"
 	"github.com/spf13/viper"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/go-rfe/gpwd/internal/logging/log"
 	pb "github.com/go-rfe/gpwd/internal/proto"
 )
 
-func NewSyncer(ctx context.Context, account *pb.Account) (pb.SyncClient, error) {
+// ErrVaultKeyMismatch is returned if the account registered from another device has a different vault key
+var ErrVaultKeyMismatch = errors.New("vault key of the account differs from the local one, create the account in the agent again")
+
+// NewSyncer registers the account on the server if needed and returns the client authenticated with the account,
+// the vault key is registered with the account and must match the key of the account registered from another device.
+// dialOpts are applied to server connections on top of the TLS credentials
+func NewSyncer(ctx context.Context, account *pb.Account, vaultKey *pb.VaultKey, dialOpts ...grpc.DialOption) (pb.SyncClient, error) {
 	auth := &pb.Auth{
 		Username: account.GetUserName(),
 		Password: account.GetUserPassword(),
 	}
 
-	login := getGRPCLoginClient(ctx, account.GetServerAddress())
+	login, err := getGRPCLoginClient(ctx, account.GetServerAddress(), dialOpts)
+	if err != nil {
+		return nil, err
+	}
 
 	if !account.Registered {
-		resp, err := login.RegisterAccount(ctx, &pb.RegisterAccountRequest{Auth: auth})
+		accountKey, err := registerAccount(ctx, auth, vaultKey, login)
 		if err != nil {
 			return nil, err
 		}
 
-		if resp.GetError() != "" {
-			return nil, errors.New(resp.GetError())
+		if accountKey != nil {
+			return nil, ErrVaultKeyMismatch
 		}
-
 	}
 
 	account.Registered = true
@@ -41,14 +53,98 @@ func NewSyncer(ctx context.Context, account *pb.Account) (pb.SyncClient, error)
 	token := tokenGenerator(ctx, auth, login)
 	interceptor := authInterceptor(token)
 
-	return getGRPCSyncClient(ctx, account.GetServerAddress(), interceptor), nil
+	return getGRPCSyncClient(ctx, account.GetServerAddress(), interceptor, dialOpts)
+}
+
+// Join registers the account with the vault key or logs in to the account registered from another device.
+// The vault key of the account is returned if it differs from the given one, so the device adopts it
+func Join(ctx context.Context, account *pb.Account, vaultKey *pb.VaultKey, dialOpts ...grpc.DialOption) (*pb.VaultKey, error) {
+	login, err := getGRPCLoginClient(ctx, account.GetServerAddress(), dialOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	return registerAccount(ctx, &pb.Auth{
+		Username: account.GetUserName(),
+		Password: account.GetUserPassword(),
+	}, vaultKey, login)
+}
+
+// UpdateVaultKey replaces the vault key of the registered account, e.g. rewrapped with the new master password
+func UpdateVaultKey(ctx context.Context, account *pb.Account, vaultKey *pb.VaultKey, dialOpts ...grpc.DialOption) error {
+	login, err := getGRPCLoginClient(ctx, account.GetServerAddress(), dialOpts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := login.UpdateVaultKey(ctx, &pb.UpdateVaultKeyRequest{
+		Auth: &pb.Auth{
+			Username: account.GetUserName(),
+			Password: account.GetUserPassword(),
+		},
+		VaultKey: vaultKey,
+	})
+	if err != nil {
+		return err
+	}
+
+	if resp.GetError() != "" {
+		return errors.New(resp.GetError())
+	}
+
+	return nil
 }
 
-func getGRPCLoginClient(ctx context.Context, serverAddress string) pb.LoginClient {
+// registerAccount registers the account on the server with the vault key. The account registered
+// from another device is taken as registered once the credentials are accepted by the server,
+// its vault key is returned if it differs from the given one. The key of the account registered
+// without one is replaced with the given key
+func registerAccount(ctx context.Context, auth *pb.Auth, vaultKey *pb.VaultKey, login pb.LoginClient) (*pb.VaultKey, error) {
+	resp, err := login.RegisterAccount(ctx, &pb.RegisterAccountRequest{Auth: auth, VaultKey: vaultKey})
+	if status.Code(err) == codes.AlreadyExists {
+		return loginAccount(ctx, auth, vaultKey, login)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.GetError() != "" {
+		return nil, errors.New(resp.GetError())
+	}
+
+	return nil, nil
+}
+
+func loginAccount(ctx context.Context, auth *pb.Auth, vaultKey *pb.VaultKey, login pb.LoginClient) (*pb.VaultKey, error) {
+	resp, err := login.Login(ctx, &pb.LoginRequest{Auth: auth})
+	if err != nil {
+		return nil, err
+	}
+
+	accountKey := resp.GetVaultKey()
+	if accountKey == nil {
+		_, err := login.UpdateVaultKey(ctx, &pb.UpdateVaultKeyRequest{Auth: auth, VaultKey: vaultKey})
+		return nil, err
+	}
+
+	if proto.Equal(accountKey, vaultKey) {
+		return nil, nil
+	}
+
+	return accountKey, nil
+}
+
+func getGRPCLoginClient(ctx context.Context, serverAddress string, dialOpts []grpc.DialOption) (pb.LoginClient, error) {
 	clientTransportCredentials, err := credentials.NewClientTLSFromFile(viper.GetString("server_cert_path"), "")
-	conn, err := grpc.DialContext(ctx, serverAddress, grpc.WithTransportCredentials(clientTransportCredentials))
 	if err != nil {
-		log.Fatal().Err(err).Msgf("Couldn't create grpc connection %s", serverAddress)
+		return nil, err
+	}
+
+	conn, err := grpc.DialContext(ctx, serverAddress,
+		append([]grpc.DialOption{grpc.WithTransportCredentials(clientTransportCredentials)}, dialOpts...)...,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create grpc connection %s: %w", serverAddress, err)
 	}
 
 	go func() {
@@ -60,23 +156,26 @@ func getGRPCLoginClient(ctx context.Context, serverAddress string) pb.LoginClien
 		}
 	}()
 
-	return pb.NewLoginClient(conn)
+	return pb.NewLoginClient(conn), nil
 }
 
-func getGRPCSyncClient(ctx context.Context, serverAddress string, interceptor grpc.StreamClientInterceptor) pb.SyncClient {
+func getGRPCSyncClient(ctx context.Context, serverAddress string, interceptor grpc.StreamClientInterceptor, dialOpts []grpc.DialOption) (pb.SyncClient, error) {
 	opts := []grpc_retry.CallOption{
 		grpc_retry.WithBackoff(grpc_retry.BackoffExponential(100 * time.Millisecond)),
 	}
 
 	clientTransportCredentials, err := credentials.NewClientTLSFromFile(viper.GetString("server_cert_path"), "")
+	if err != nil {
+		return nil, err
+	}
 
-	conn, err := grpc.DialContext(ctx, serverAddress,
+	conn, err := grpc.DialContext(ctx, serverAddress, append([]grpc.DialOption{
 		grpc.WithTransportCredentials(clientTransportCredentials),
 		grpc.WithStreamInterceptor(grpc_middleware.ChainStreamClient(grpc_retry.StreamClientInterceptor(opts...), interceptor)),
 		grpc.WithUnaryInterceptor(grpc_middleware.ChainUnaryClient(grpc_retry.UnaryClientInterceptor(opts...))),
-	)
+	}, dialOpts...)...)
 	if err != nil {
-		log.Fatal().Err(err).Msgf("Couldn't create grpc connection %s", serverAddress)
+		return nil, fmt.Errorf("couldn't create grpc connection %s: %w", serverAddress, err)
 	}
 
 	go func() {
@@ -88,7 +187,7 @@ func getGRPCSyncClient(ctx context.Context, serverAddress string, interceptor gr
 		}
 	}()
 
-	return pb.NewSyncClient(conn)
+	return pb.NewSyncClient(conn), nil
 }
 
 func authInterceptor(token func() string) grpc.StreamClientInterceptor {